@@ -0,0 +1,207 @@
+// Package aoc provides a client for the adventofcode.com website.
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Default settings of clients returned by NewClient.
+const (
+	DefaultBaseURL    = "https://adventofcode.com"
+	DefaultUserAgent  = "github.com/busser/adventofcode"
+	DefaultTimeout    = 30 * time.Second
+	DefaultMaxRetries = 3
+	DefaultBackoff    = time.Second
+)
+
+var (
+	// ErrBadCookie is returned when the website rejects the session cookie.
+	ErrBadCookie = errors.New("session cookie rejected")
+	// ErrNotFound is returned when the requested page does not exist, usually
+	// because the puzzle is not unlocked yet.
+	ErrNotFound = errors.New("not found; is the puzzle unlocked?")
+)
+
+// A StatusError is returned when the website responds with an unexpected
+// status code.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("adventofcode.com responded with %d: %s", e.StatusCode, e.Body)
+}
+
+// Unwrap returns the sentinel error matching e's status code, if any.
+func (e *StatusError) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return ErrBadCookie
+	case http.StatusNotFound:
+		return ErrNotFound
+	default:
+		return nil
+	}
+}
+
+// A Client sends requests to the Advent of Code website on behalf of a user.
+type Client struct {
+	// Address of the website. Defaults to DefaultBaseURL.
+	BaseURL string
+
+	// Session cookie of the user.
+	Cookie string
+
+	// Value of the User-Agent header sent with every request.
+	UserAgent string
+
+	// How many times GET requests are retried when the website responds with
+	// a server error, and how long to wait before the first retry. The delay
+	// doubles with each retry.
+	MaxRetries int
+	Backoff    time.Duration
+
+	// Minimum delay between the start of two requests, to avoid hammering the
+	// website when sending many requests in a row. Zero disables the limit.
+	Interval time.Duration
+
+	// HTTP client used to send requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	// Function used to wait between retries. Defaults to time.Sleep.
+	sleep func(time.Duration)
+
+	// Function used to get the current time. Defaults to time.Now.
+	now func() time.Time
+
+	// When the last request was sent.
+	lastRequest time.Time
+}
+
+// NewClient returns a client for the Advent of Code website that authenticates
+// with the given session cookie.
+func NewClient(cookie string) *Client {
+	return &Client{
+		BaseURL:    DefaultBaseURL,
+		Cookie:     cookie,
+		UserAgent:  DefaultUserAgent,
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    DefaultBackoff,
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// do sends a request to the given path and returns the response body. It
+// returns a *StatusError if the website does not respond with 200 OK. GET
+// requests are retried on server errors.
+func (c *Client) do(method, path string, form url.Values) ([]byte, error) {
+	retries := 0
+	if method == http.MethodGet {
+		retries = c.MaxRetries
+	}
+
+	backoff := c.Backoff
+	for attempt := 0; ; attempt++ {
+		body, err := c.doOnce(method, path, form)
+
+		var statusErr *StatusError
+		serverError := errors.As(err, &statusErr) && statusErr.StatusCode >= 500
+		if !serverError || attempt >= retries {
+			return body, err
+		}
+
+		c.wait(backoff)
+		backoff *= 2
+	}
+}
+
+// doOnce sends a single request to the given path.
+func (c *Client) doOnce(method, path string, form url.Values) ([]byte, error) {
+	c.throttle()
+
+	u := strings.TrimSuffix(c.baseURL(), "/") + path
+
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("preparing %s request to %q: %w", method, u, err)
+	}
+
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Cookie})
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending %s request to %q: %w", method, u, err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response from %q: %w", u, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(content)),
+		}
+	}
+
+	return content, nil
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL == "" {
+		return DefaultBaseURL
+	}
+	return c.BaseURL
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+// throttle waits until c.Interval has passed since the last request.
+func (c *Client) throttle() {
+	if c.Interval > 0 && !c.lastRequest.IsZero() {
+		if d := c.Interval - c.clock().Sub(c.lastRequest); d > 0 {
+			c.wait(d)
+		}
+	}
+	c.lastRequest = c.clock()
+}
+
+func (c *Client) clock() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
+
+func (c *Client) wait(d time.Duration) {
+	if c.sleep == nil {
+		time.Sleep(d)
+		return
+	}
+	c.sleep(d)
+}
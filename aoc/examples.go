@@ -0,0 +1,107 @@
+package aoc
+
+import "strings"
+
+// An Example is a small input given in the description of a puzzle, along
+// with the answers the description gives for it.
+type Example struct {
+	Input string
+
+	// Answers to each part of the puzzle this example applies to, keyed by part
+	// number. An empty answer means the example applies to the part, but its
+	// answer could not be detected.
+	Answers map[int]string
+}
+
+// Examples extracts worked examples from the puzzle's description.
+//
+// Examples are the code blocks of the description. The answer to a part is
+// assumed to be the last emphasized code in its description, such as
+// <code><em>42</em></code>, and to apply to the last code block before it.
+// If the description of a part has no code block, the part applies to the last
+// example of the previous part.
+func (p *Puzzle) Examples() []Example {
+	var examples []Example
+	indexOf := make(map[string]int)
+
+	// Index of the last example seen, across all parts.
+	last := -1
+
+	for i, part := range p.parts {
+		number := i + 1
+
+		// Last example of this part, and example the answer applies to.
+		partLast, answerTarget := -1, -1
+		answer := ""
+
+		var visit func(n *htmlNode)
+		visit = func(n *htmlNode) {
+			switch {
+			case n.Tag == "pre":
+				input := n.textContent()
+				if strings.TrimSpace(input) == "" {
+					return
+				}
+				index, ok := indexOf[input]
+				if !ok {
+					index = len(examples)
+					indexOf[input] = index
+					examples = append(examples, Example{Input: input})
+				}
+				last, partLast = index, index
+				return
+			case n.Tag == "code" && isEmphasizedCode(n):
+				if last >= 0 {
+					answerTarget, answer = last, strings.TrimSpace(n.textContent())
+				}
+				return
+			}
+			for _, child := range n.Children {
+				visit(child)
+			}
+		}
+		visit(part)
+
+		target := answerTarget
+		if target < 0 {
+			target = partLast
+		}
+		if target < 0 {
+			target = last
+		}
+		if target < 0 {
+			continue
+		}
+
+		if examples[target].Answers == nil {
+			examples[target].Answers = make(map[int]string)
+		}
+		examples[target].Answers[number] = answer
+	}
+
+	// Only keep examples that apply to at least one part.
+	var used []Example
+	for _, e := range examples {
+		if len(e.Answers) > 0 {
+			used = append(used, e)
+		}
+	}
+
+	return used
+}
+
+// isEmphasizedCode reports whether n is a code element whose content is
+// entirely emphasized, which is how the website highlights answers.
+func isEmphasizedCode(n *htmlNode) bool {
+	var em *htmlNode
+	for _, child := range n.Children {
+		if child.Tag == "" && strings.TrimSpace(child.Text) == "" {
+			continue
+		}
+		if child.Tag != "em" || em != nil {
+			return false
+		}
+		em = child
+	}
+	return em != nil && strings.TrimSpace(em.textContent()) != ""
+}
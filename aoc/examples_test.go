@@ -0,0 +1,81 @@
+package aoc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPuzzleExamples(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "puzzle", "both-parts.html"))
+	if err != nil {
+		t.Fatalf("could not read test data file: %v", err)
+	}
+
+	testCases := []struct {
+		name string
+		page string
+		want []Example
+	}{
+		{
+			name: "shared_example",
+			page: string(page),
+			want: []Example{
+				{Input: "3   4\n4   3\n2   5\n", Answers: map[int]string{1: "3", 2: "7"}},
+			},
+		},
+		{
+			name: "separate_examples",
+			page: `<article class="day-desc"><h2>--- Day 3: Test ---</h2>
+<pre><code>abc
+</code></pre>
+<p>Not the answer: <code><em>x</em></code>.</p>
+<pre><code>ab
+</code></pre>
+<p>The answer is <code><em>2</em></code>.</p>
+<pre><code>illustration
+</code></pre>
+</article>
+<article class="day-desc"><h2>--- Part Two ---</h2>
+<pre><code>xyz
+</code></pre>
+<p>Here, the answer is <code><em>26</em></code>.</p>
+</article>`,
+			want: []Example{
+				{Input: "ab\n", Answers: map[int]string{1: "2"}},
+				{Input: "xyz\n", Answers: map[int]string{2: "26"}},
+			},
+		},
+		{
+			name: "unknown_answer",
+			page: `<article class="day-desc"><h2>--- Day 4: Test ---</h2>
+<pre><code>1 2 3
+</code></pre>
+<p>What is the <em>answer</em>?</p>
+</article>`,
+			want: []Example{
+				{Input: "1 2 3\n", Answers: map[int]string{1: ""}},
+			},
+		},
+		{
+			name: "no_examples",
+			page: `<article class="day-desc"><h2>--- Day 5: Test ---</h2><p>No example.</p></article>`,
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			puzzle, err := ParsePuzzle(2024, 1, tc.page)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, puzzle.Examples()); diff != "" {
+				t.Errorf("examples mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
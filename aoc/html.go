@@ -0,0 +1,90 @@
+package aoc
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// An htmlNode is an element or a text node of an HTML document.
+type htmlNode struct {
+	// Tag is empty for text nodes.
+	Tag      string
+	Attrs    map[string]string
+	Text     string
+	Children []*htmlNode
+}
+
+// parseHTMLFragment parses a fragment of HTML into a tree of nodes. The
+// fragment is wrapped in a root node with an empty tag. The parser is lenient,
+// but expects the fragment to be mostly well-formed, as the pages of the
+// Advent of Code website are.
+func parseHTMLFragment(fragment string) (*htmlNode, error) {
+	d := xml.NewDecoder(strings.NewReader(fragment))
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+
+	root := &htmlNode{}
+	stack := []*htmlNode{root}
+
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing HTML: %w", err)
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			node := &htmlNode{
+				Tag:   strings.ToLower(tok.Name.Local),
+				Attrs: make(map[string]string, len(tok.Attr)),
+			}
+			for _, attr := range tok.Attr {
+				node.Attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+			parent.Children = append(parent.Children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.Children = append(parent.Children, &htmlNode{Text: string(tok)})
+		}
+	}
+
+	return root, nil
+}
+
+// textContent returns the concatenated text of n and its descendants.
+func (n *htmlNode) textContent() string {
+	if n.Tag == "" && len(n.Children) == 0 {
+		return n.Text
+	}
+
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(child.textContent())
+	}
+	return b.String()
+}
+
+// findAll returns all descendants of n with the given tag, in document order.
+func (n *htmlNode) findAll(tag string) []*htmlNode {
+	var found []*htmlNode
+	for _, child := range n.Children {
+		if child.Tag == tag {
+			found = append(found, child)
+		}
+		found = append(found, child.findAll(tag)...)
+	}
+	return found
+}
@@ -0,0 +1,11 @@
+package aoc
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DownloadInput fetches the user's input for the puzzle of the given day.
+func (c *Client) DownloadInput(year, day int) ([]byte, error) {
+	return c.do(http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+}
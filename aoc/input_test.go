@@ -0,0 +1,207 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fakeWebsite stands in for adventofcode.com. It responds to each request with
+// the next status code in statuses, and keeps responding with the last one
+// once all others were used.
+type fakeWebsite struct {
+	t        *testing.T
+	statuses []int
+	requests int
+}
+
+func (f *fakeWebsite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	status := f.statuses[min(f.requests, len(f.statuses)-1)]
+	f.requests++
+
+	if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "s3cr3t" {
+		f.t.Errorf("missing or wrong session cookie")
+	}
+	if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+		f.t.Errorf("got User-Agent %q, want %q", got, DefaultUserAgent)
+	}
+
+	switch status {
+	case http.StatusOK:
+		fmt.Fprintf(w, "input for %s\n", r.URL.Path)
+	case http.StatusBadRequest:
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", status)
+	case http.StatusNotFound:
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks! The calendar countdown is synchronized with the server time; the link will be enabled on the calendar the instant this puzzle becomes available.", status)
+	default:
+		http.Error(w, http.StatusText(status), status)
+	}
+}
+
+// newTestClient returns a client for a fakeWebsite that responds with
+// statuses. The client does not wait between retries.
+func newTestClient(t *testing.T, statuses ...int) (*Client, *fakeWebsite) {
+	t.Helper()
+
+	website := &fakeWebsite{t: t, statuses: statuses}
+	server := httptest.NewServer(website)
+	t.Cleanup(server.Close)
+
+	client := NewClient("s3cr3t")
+	client.BaseURL = server.URL
+	client.sleep = func(time.Duration) {}
+
+	return client, website
+}
+
+func TestDownloadInput(t *testing.T) {
+	testCases := []struct {
+		name         string
+		statuses     []int
+		wantErr      error
+		wantStatus   int
+		wantRequests int
+	}{
+		{
+			name:         "ok",
+			statuses:     []int{http.StatusOK},
+			wantRequests: 1,
+		},
+		{
+			name:         "bad_cookie",
+			statuses:     []int{http.StatusBadRequest},
+			wantErr:      ErrBadCookie,
+			wantStatus:   http.StatusBadRequest,
+			wantRequests: 1,
+		},
+		{
+			name:         "not_unlocked",
+			statuses:     []int{http.StatusNotFound},
+			wantErr:      ErrNotFound,
+			wantStatus:   http.StatusNotFound,
+			wantRequests: 1,
+		},
+		{
+			name:         "server_error_then_ok",
+			statuses:     []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			wantRequests: 3,
+		},
+		{
+			name:         "server_error",
+			statuses:     []int{http.StatusInternalServerError},
+			wantStatus:   http.StatusInternalServerError,
+			wantRequests: 1 + DefaultMaxRetries,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, website := newTestClient(t, tc.statuses...)
+
+			input, err := client.DownloadInput(2024, 17)
+
+			if website.requests != tc.wantRequests {
+				t.Errorf("got %d requests, want %d", website.requests, tc.wantRequests)
+			}
+
+			if tc.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if string(input) != "input for /2024/day/17/input\n" {
+					t.Errorf("got input %q", input)
+				}
+				return
+			}
+
+			var statusErr *StatusError
+			if !errors.As(err, &statusErr) || statusErr.StatusCode != tc.wantStatus {
+				t.Fatalf("expected status error %d, got %v", tc.wantStatus, err)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	client, _ := newTestClient(t, http.StatusServiceUnavailable)
+
+	var waits []time.Duration
+	client.Backoff = 100 * time.Millisecond
+	client.sleep = func(d time.Duration) { waits = append(waits, d) }
+
+	if _, err := client.DownloadInput(2024, 17); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	if len(waits) != len(want) {
+		t.Fatalf("got waits %v, want %v", waits, want)
+	}
+	for i := range want {
+		if waits[i] != want[i] {
+			t.Fatalf("got waits %v, want %v", waits, want)
+		}
+	}
+}
+
+func TestInterval(t *testing.T) {
+	client, website := newTestClient(t, http.StatusOK)
+
+	now := time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC)
+	var waits []time.Duration
+	client.Interval = 3 * time.Second
+	client.now = func() time.Time { return now }
+	client.sleep = func(d time.Duration) {
+		waits = append(waits, d)
+		now = now.Add(d)
+	}
+
+	for range 2 {
+		if _, err := client.DownloadInput(2024, 17); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Time passing between requests counts towards the interval.
+	now = now.Add(time.Second)
+	if _, err := client.DownloadInput(2024, 17); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []time.Duration{3 * time.Second, 2 * time.Second}
+	if website.requests != 3 || len(waits) != len(want) || waits[0] != want[0] || waits[1] != want[1] {
+		t.Errorf("got %d requests and waits %v, want 3 requests and waits %v", website.requests, waits, want)
+	}
+}
+
+func TestSubmitIsNotRetried(t *testing.T) {
+	client, website := newTestClient(t, http.StatusInternalServerError)
+
+	if _, err := client.SubmitAnswer(2024, 17, 1, "42"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if website.requests != 1 {
+		t.Errorf("got %d requests, want 1", website.requests)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient("s3cr3t")
+	client.BaseURL = server.URL
+	client.HTTPClient.Timeout = 10 * time.Millisecond
+
+	if _, err := client.DownloadInput(2024, 17); err == nil {
+		t.Fatalf("expected a timeout error")
+	}
+}
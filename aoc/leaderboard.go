@@ -0,0 +1,12 @@
+package aoc
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// DownloadLeaderboard fetches the private leaderboard with the given ID, as
+// JSON. The website asks that this be done at most once every 15 minutes.
+func (c *Client) DownloadLeaderboard(year, id int) ([]byte, error) {
+	return c.do(http.MethodGet, fmt.Sprintf("/%d/leaderboard/private/view/%d.json", year, id), nil)
+}
@@ -0,0 +1,19 @@
+package aoc
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestDownloadLeaderboard(t *testing.T) {
+	client, _ := newTestClient(t, http.StatusOK)
+
+	content, err := client.DownloadLeaderboard(2024, 123456)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "input for /2024/leaderboard/private/view/123456.json\n"; string(content) != want {
+		t.Errorf("got %q, want %q", content, want)
+	}
+}
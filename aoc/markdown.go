@@ -0,0 +1,215 @@
+package aoc
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// markdownRenderer converts HTML nodes from puzzle descriptions to Markdown.
+type markdownRenderer struct {
+	// Page the nodes come from, used to resolve relative links.
+	base *url.URL
+}
+
+// blocks renders the children of n as Markdown blocks separated by blank
+// lines.
+func (r *markdownRenderer) blocks(n *htmlNode) string {
+	var blocks []string
+	var inline strings.Builder
+
+	flush := func() {
+		if text := strings.TrimSpace(inline.String()); text != "" {
+			blocks = append(blocks, text)
+		}
+		inline.Reset()
+	}
+
+	for _, child := range n.Children {
+		switch child.Tag {
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			flush()
+			level := int(child.Tag[1] - '0')
+			blocks = append(blocks, strings.Repeat("#", level)+" "+headingText(child))
+		case "p", "div":
+			flush()
+			if text := strings.TrimSpace(r.inline(child)); text != "" {
+				blocks = append(blocks, text)
+			}
+		case "pre":
+			flush()
+			blocks = append(blocks, "```\n"+strings.TrimRight(child.textContent(), "\n")+"\n```")
+		case "ul", "ol":
+			flush()
+			blocks = append(blocks, r.list(child))
+		case "blockquote":
+			flush()
+			quoted := strings.Split(r.blocks(child), "\n")
+			for i, line := range quoted {
+				quoted[i] = strings.TrimRight("> "+line, " ")
+			}
+			blocks = append(blocks, strings.Join(quoted, "\n"))
+		default:
+			inline.WriteString(r.inlineNode(child))
+		}
+	}
+	flush()
+
+	return strings.Join(blocks, "\n\n")
+}
+
+// list renders a ul or ol element as a Markdown list.
+func (r *markdownRenderer) list(n *htmlNode) string {
+	var items []string
+	number := 0
+
+	for _, child := range n.Children {
+		if child.Tag != "li" {
+			continue
+		}
+		number++
+
+		marker := "- "
+		if n.Tag == "ol" {
+			marker = fmt.Sprintf("%d. ", number)
+		}
+		indent := strings.Repeat(" ", len(marker))
+
+		lines := strings.Split(r.blocks(child), "\n")
+		for i := range lines {
+			if i > 0 && lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// inline renders the children of n as inline Markdown.
+func (r *markdownRenderer) inline(n *htmlNode) string {
+	var b strings.Builder
+	for _, child := range n.Children {
+		b.WriteString(r.inlineNode(child))
+	}
+	return b.String()
+}
+
+// inlineNode renders n as inline Markdown.
+func (r *markdownRenderer) inlineNode(n *htmlNode) string {
+	switch n.Tag {
+	case "":
+		return escapeMarkdown(collapseSpaces(n.Text))
+	case "em", "i", "b", "strong":
+		return emphasize(r.inline(n), "*")
+	case "code":
+		return r.code(n)
+	case "a":
+		text := r.inline(n)
+		href, ok := n.Attrs["href"]
+		if !ok {
+			return text
+		}
+		return fmt.Sprintf("[%s](%s)", text, r.resolve(href))
+	case "br":
+		return "  \n"
+	case "script", "style":
+		return ""
+	default:
+		return r.inline(n)
+	}
+}
+
+// code renders an inline code element. Markdown does not support emphasis
+// inside code spans, so emphasized code is rendered as bold code instead.
+func (r *markdownRenderer) code(n *htmlNode) string {
+	text := n.textContent()
+
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	span := fence + text + fence
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		span = fence + " " + text + " " + fence
+	}
+
+	if len(n.findAll("em")) > 0 {
+		return "**" + span + "**"
+	}
+	return span
+}
+
+// resolve returns href as an absolute URL, if possible.
+func (r *markdownRenderer) resolve(href string) string {
+	if r.base == nil {
+		return href
+	}
+	u, err := r.base.Parse(href)
+	if err != nil {
+		return href
+	}
+	return u.String()
+}
+
+// headingText returns the text of a puzzle heading, without the dashes that
+// surround it on the website.
+func headingText(n *htmlNode) string {
+	text := strings.TrimSpace(collapseSpaces(n.textContent()))
+	text = strings.TrimPrefix(text, "--- ")
+	text = strings.TrimSuffix(text, " ---")
+	return text
+}
+
+// emphasize wraps text in marker, keeping surrounding spaces outside of the
+// emphasis so that Markdown renders it correctly.
+func emphasize(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+
+	start := strings.Index(text, trimmed)
+	end := start + len(trimmed)
+
+	return text[:start] + marker + trimmed + marker + text[end:]
+}
+
+// collapseSpaces replaces every sequence of whitespace in s with a single
+// space, as browsers do when rendering HTML.
+func collapseSpaces(s string) string {
+	var b strings.Builder
+	space := false
+	for _, c := range s {
+		if c == ' ' || c == '\n' || c == '\t' || c == '\r' {
+			space = true
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(c)
+	}
+	if space {
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `&lt;`,
+)
+
+// escapeMarkdown escapes characters in text that Markdown would otherwise
+// interpret.
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
@@ -0,0 +1,129 @@
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// A Puzzle is the description of an Advent of Code puzzle, as shown on the
+// website.
+type Puzzle struct {
+	Year, Day int
+
+	// Title of the puzzle, such as "Historian Hysteria".
+	Title string
+
+	// Descriptions of each part of the puzzle available to the user. The
+	// second part is only included once the user has solved the first.
+	parts []*htmlNode
+}
+
+// DownloadPuzzle fetches the description of the puzzle of the given day. The
+// second part of the puzzle is only included if the client's session cookie
+// belongs to a user who solved the first part.
+func (c *Client) DownloadPuzzle(year, day int) (*Puzzle, error) {
+	page, err := c.DownloadPuzzlePage(year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePuzzle(year, day, string(page))
+}
+
+// DownloadPuzzlePage fetches the page of the puzzle of the given day, as
+// parsed by ParsePuzzle.
+func (c *Client) DownloadPuzzlePage(year, day int) ([]byte, error) {
+	return c.do(http.MethodGet, puzzlePath(year, day), nil)
+}
+
+// PuzzleURL returns the address of the puzzle of the given day on the website.
+func (c *Client) PuzzleURL(year, day int) string {
+	return strings.TrimSuffix(c.baseURL(), "/") + puzzlePath(year, day)
+}
+
+func puzzlePath(year, day int) string {
+	return fmt.Sprintf("/%d/day/%d", year, day)
+}
+
+var (
+	descriptionPattern = regexp.MustCompile(`(?s)<article class="day-desc">(.*?)</article>`)
+	titlePattern       = regexp.MustCompile(`^Day \d+: (.*)$`)
+)
+
+// ParsePuzzle extracts the description of a puzzle from a page of the website.
+func ParsePuzzle(year, day int, page string) (*Puzzle, error) {
+	matches := descriptionPattern.FindAllStringSubmatch(page, -1)
+	if len(matches) == 0 {
+		return nil, errors.New("no puzzle description found in page")
+	}
+
+	puzzle := &Puzzle{Year: year, Day: day}
+
+	for _, match := range matches {
+		article, err := parseHTMLFragment(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing part %d: %w", len(puzzle.parts)+1, err)
+		}
+		puzzle.parts = append(puzzle.parts, article)
+	}
+
+	if headings := puzzle.parts[0].findAll("h2"); len(headings) > 0 {
+		heading := headingText(headings[0])
+		if match := titlePattern.FindStringSubmatch(heading); match != nil {
+			puzzle.Title = match[1]
+		} else {
+			puzzle.Title = heading
+		}
+	}
+
+	return puzzle, nil
+}
+
+// Parts returns the number of parts of the puzzle available to the user.
+func (p *Puzzle) Parts() int {
+	return len(p.parts)
+}
+
+// Markdown renders the description of the puzzle as a Markdown document.
+// Relative links are resolved against pageURL.
+func (p *Puzzle) Markdown(pageURL string) string {
+	r := &markdownRenderer{}
+	if base, err := url.Parse(pageURL); err == nil {
+		r.base = base
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Day %d: %s\n\n", p.Day, p.Title)
+	fmt.Fprintf(&b, "Puzzle from [Advent of Code %d](%s).\n", p.Year, pageURL)
+
+	for i, part := range p.parts {
+		if i > 0 {
+			fmt.Fprintf(&b, "\n%s\n", PartHeading(i+1))
+		}
+
+		body := &htmlNode{}
+		for _, child := range part.Children {
+			if child.Tag != "h2" {
+				body.Children = append(body.Children, child)
+			}
+		}
+		fmt.Fprintf(&b, "\n%s\n", r.blocks(body))
+	}
+
+	return b.String()
+}
+
+// PartHeading returns the Markdown heading that introduces the given part of a
+// puzzle in descriptions rendered by Puzzle.Markdown.
+func PartHeading(part int) string {
+	names := []string{"One", "Two"}
+	if part < 1 || part > len(names) {
+		return fmt.Sprintf("## Part %d", part)
+	}
+	return "## Part " + names[part-1]
+}
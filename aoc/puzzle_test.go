@@ -0,0 +1,88 @@
+package aoc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPuzzleMarkdown(t *testing.T) {
+	testCases := []struct {
+		name      string
+		wantParts int
+	}{
+		{name: "part-one", wantParts: 1},
+		{name: "both-parts", wantParts: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := os.ReadFile(filepath.Join("testdata", "puzzle", tc.name+".html"))
+			if err != nil {
+				t.Fatalf("could not read test data file: %v", err)
+			}
+			want, err := os.ReadFile(filepath.Join("testdata", "puzzle", tc.name+".md"))
+			if err != nil {
+				t.Fatalf("could not read test data file: %v", err)
+			}
+
+			puzzle, err := ParsePuzzle(2024, 1, string(page))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if puzzle.Title != "Sorting Socks" {
+				t.Errorf("got title %q, want %q", puzzle.Title, "Sorting Socks")
+			}
+			if puzzle.Parts() != tc.wantParts {
+				t.Errorf("got %d parts, want %d", puzzle.Parts(), tc.wantParts)
+			}
+
+			got := puzzle.Markdown("https://adventofcode.com/2024/day/1")
+			if diff := cmp.Diff(string(want), got); diff != "" {
+				t.Errorf("markdown mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParsePuzzleWithoutDescription(t *testing.T) {
+	if _, err := ParsePuzzle(2024, 1, "<html><body><main></main></body></html>"); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestDownloadPuzzle(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "puzzle", "both-parts.html"))
+	if err != nil {
+		t.Fatalf("could not read test data file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024/day/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(page)
+	}))
+	defer server.Close()
+
+	client := NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	puzzle, err := client.DownloadPuzzle(2024, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if puzzle.Parts() != 2 {
+		t.Errorf("got %d parts, want 2", puzzle.Parts())
+	}
+
+	if got, want := client.PuzzleURL(2024, 1), server.URL+"/2024/day/1"; got != want {
+		t.Errorf("got URL %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,116 @@
+package aoc
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A Verdict is the website's response to a submitted answer.
+type Verdict string
+
+// Possible verdicts.
+const (
+	Correct     Verdict = "correct"
+	TooHigh     Verdict = "too-high"
+	TooLow      Verdict = "too-low"
+	Incorrect   Verdict = "incorrect"
+	RateLimited Verdict = "rate-limited"
+	WrongLevel  Verdict = "wrong-level"
+)
+
+// A SubmitResult describes the outcome of submitting an answer.
+type SubmitResult struct {
+	Verdict Verdict
+
+	// How long to wait before submitting another answer, if the website said
+	// so.
+	Wait time.Duration
+
+	// The main message of the website's response, stripped of HTML tags.
+	Message string
+}
+
+// SubmitAnswer submits the answer to the given part of a puzzle.
+func (c *Client) SubmitAnswer(year, day, part int, answer string) (SubmitResult, error) {
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	body, err := c.do(http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), form)
+	if err != nil {
+		return SubmitResult{}, err
+	}
+
+	return ParseSubmitResponse(string(body))
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	waitPattern    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	penaltyPattern = regexp.MustCompile(`(?i)please wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseSubmitResponse extracts the verdict from the HTML page returned by the
+// website after submitting an answer.
+func ParseSubmitResponse(page string) (SubmitResult, error) {
+	match := articlePattern.FindStringSubmatch(page)
+	if match == nil {
+		return SubmitResult{}, fmt.Errorf("no message found in response")
+	}
+
+	message := tagPattern.ReplaceAllString(match[1], "")
+	message = strings.Join(strings.Fields(message), " ")
+
+	result := SubmitResult{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		result.Verdict = Correct
+	case strings.Contains(message, "That's not the right answer"):
+		switch {
+		case strings.Contains(message, "your answer is too high"):
+			result.Verdict = TooHigh
+		case strings.Contains(message, "your answer is too low"):
+			result.Verdict = TooLow
+		default:
+			result.Verdict = Incorrect
+		}
+		result.Wait = parseWait(message)
+	case strings.Contains(message, "You gave an answer too recently"):
+		result.Verdict = RateLimited
+		result.Wait = parseWait(message)
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		result.Verdict = WrongLevel
+	default:
+		return result, fmt.Errorf("unexpected response: %s", message)
+	}
+
+	return result, nil
+}
+
+// parseWait extracts the time to wait before the next submission from message.
+func parseWait(message string) time.Duration {
+	if match := waitPattern.FindStringSubmatch(message); match != nil {
+		minutes, _ := strconv.Atoi(match[1])
+		seconds, _ := strconv.Atoi(match[2])
+		return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	}
+
+	// Wrong answers come with a penalty expressed in minutes.
+	if match := penaltyPattern.FindStringSubmatch(message); match != nil {
+		if match[1] == "one" {
+			return time.Minute
+		}
+		minutes, _ := strconv.Atoi(match[1])
+		return time.Duration(minutes) * time.Minute
+	}
+
+	return 0
+}
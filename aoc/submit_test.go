@@ -0,0 +1,97 @@
+package aoc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseSubmitResponse(t *testing.T) {
+	testCases := []struct {
+		file        string
+		wantVerdict Verdict
+		wantWait    time.Duration
+	}{
+		{file: "correct.html", wantVerdict: Correct},
+		{file: "too-high.html", wantVerdict: TooHigh, wantWait: time.Minute},
+		{file: "too-low.html", wantVerdict: TooLow, wantWait: time.Minute},
+		{file: "incorrect.html", wantVerdict: Incorrect, wantWait: 5 * time.Minute},
+		{file: "rate-limited.html", wantVerdict: RateLimited, wantWait: time.Minute + 23*time.Second},
+		{file: "wrong-level.html", wantVerdict: WrongLevel},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			page, err := os.ReadFile(filepath.Join("testdata", "submit", tc.file))
+			if err != nil {
+				t.Fatalf("could not read test data file: %v", err)
+			}
+
+			result, err := ParseSubmitResponse(string(page))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if result.Verdict != tc.wantVerdict {
+				t.Errorf("got verdict %q, want %q", result.Verdict, tc.wantVerdict)
+			}
+			if result.Wait != tc.wantWait {
+				t.Errorf("got wait %s, want %s", result.Wait, tc.wantWait)
+			}
+			if result.Message == "" {
+				t.Errorf("got empty message")
+			}
+		})
+	}
+}
+
+func TestParseSubmitResponseUnexpected(t *testing.T) {
+	if _, err := ParseSubmitResponse("<html><body>Hello!</body></html>"); err == nil {
+		t.Errorf("expected error for page without article")
+	}
+	if _, err := ParseSubmitResponse("<article><p>Something new.</p></article>"); err == nil {
+		t.Errorf("expected error for unknown message")
+	}
+}
+
+func TestSubmitAnswer(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "submit", "correct.html"))
+	if err != nil {
+		t.Fatalf("could not read test data file: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got method %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/2024/day/17/answer" {
+			t.Errorf("got path %q, want %q", r.URL.Path, "/2024/day/17/answer")
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "s3cr3t" {
+			t.Errorf("missing or wrong session cookie")
+		}
+		if got := r.FormValue("level"); got != "2" {
+			t.Errorf("got level %q, want %q", got, "2")
+		}
+		if got := r.FormValue("answer"); got != "265652340990875" {
+			t.Errorf("got answer %q, want %q", got, "265652340990875")
+		}
+
+		w.Write(page)
+	}))
+	defer server.Close()
+
+	client := NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	result, err := client.SubmitAnswer(2024, 17, 2, "265652340990875")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Verdict != Correct {
+		t.Errorf("got verdict %q, want %q", result.Verdict, Correct)
+	}
+}
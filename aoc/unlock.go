@@ -0,0 +1,59 @@
+package aoc
+
+import (
+	"fmt"
+	"time"
+)
+
+// FirstYear is the year of the first Advent of Code.
+const FirstYear = 2015
+
+// unlockZone is the time zone of puzzle unlocks: midnight, UTC-5.
+var unlockZone = time.FixedZone("UTC-5", -5*60*60)
+
+// DaysInYear returns the number of puzzles in the given year's calendar.
+// Starting in 2025, the calendar has 12 puzzles instead of 25.
+func DaysInYear(year int) int {
+	if year >= 2025 {
+		return 12
+	}
+	return 25
+}
+
+// UnlockTime returns when the puzzle of the given day is unlocked.
+func UnlockTime(year, day int) time.Time {
+	return time.Date(year, time.December, day, 0, 0, 0, 0, unlockZone)
+}
+
+// Unlocked reports whether the puzzle of the given day is unlocked at now.
+func Unlocked(year, day int, now time.Time) bool {
+	return !now.Before(UnlockTime(year, day))
+}
+
+// NextUnlock returns the first puzzle still locked at now.
+func NextUnlock(now time.Time) (year, day int) {
+	year = now.In(unlockZone).Year()
+	for day := 1; day <= DaysInYear(year); day++ {
+		if !Unlocked(year, day, now) {
+			return year, day
+		}
+	}
+	return year + 1, 1
+}
+
+// FormatClock formats a duration truncated to the second, such as "01:02:03",
+// or "2d 01:02:03" for more than a day. It shows both countdowns to an unlock
+// and the time taken to solve a puzzle.
+func FormatClock(d time.Duration) string {
+	seconds := int64(d / time.Second)
+
+	days := seconds / 86400
+	hours := seconds % 86400 / 3600
+	minutes := seconds % 3600 / 60
+	seconds %= 60
+
+	if days > 0 {
+		return fmt.Sprintf("%dd %02d:%02d:%02d", days, hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
+}
@@ -0,0 +1,61 @@
+package aoc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnlockTime(t *testing.T) {
+	got := UnlockTime(2024, 1)
+	want := time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if Unlocked(2024, 1, want.Add(-time.Nanosecond)) {
+		t.Errorf("puzzle unlocked before its time")
+	}
+	if !Unlocked(2024, 1, want) {
+		t.Errorf("puzzle not unlocked on time")
+	}
+}
+
+func TestNextUnlock(t *testing.T) {
+	testCases := []struct {
+		now      time.Time
+		wantYear int
+		wantDay  int
+	}{
+		{time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC), 2024, 1},
+		{time.Date(2024, time.December, 1, 4, 59, 59, 0, time.UTC), 2024, 1},
+		{time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC), 2024, 2},
+		{time.Date(2024, time.December, 25, 5, 0, 0, 0, time.UTC), 2025, 1},
+		{time.Date(2025, time.December, 11, 12, 0, 0, 0, time.UTC), 2025, 12},
+		{time.Date(2025, time.December, 12, 5, 0, 0, 0, time.UTC), 2026, 1},
+		// New Year's Eve in UTC-5 is already the next year in UTC.
+		{time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC), 2025, 1},
+		{time.Date(2025, time.December, 31, 23, 0, 0, 0, time.UTC), 2026, 1},
+	}
+
+	for _, tc := range testCases {
+		year, day := NextUnlock(tc.now)
+		if year != tc.wantYear || day != tc.wantDay {
+			t.Errorf("NextUnlock(%v) = %d day %d, want %d day %d", tc.now, year, day, tc.wantYear, tc.wantDay)
+		}
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	testCases := map[time.Duration]string{
+		0:                                  "00:00:00",
+		90 * time.Second:                   "00:01:30",
+		23*time.Hour + 59*time.Minute:      "23:59:00",
+		50*time.Hour + 3*time.Second:       "2d 02:00:03",
+		time.Second + 999*time.Millisecond: "00:00:01",
+	}
+	for d, want := range testCases {
+		if got := FormatClock(d); got != want {
+			t.Errorf("FormatClock(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Package benchmark measures the performance of registered solutions against
+// their puzzle inputs.
+package benchmark
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/busser/adventofcode/registry"
+)
+
+// A Result holds measurements of a solution's performance.
+type Result struct {
+	registry.Key
+
+	// Durations of each run of the solution.
+	Samples []time.Duration `json:"samples_ns"`
+
+	// Statistics over all samples.
+	Min    time.Duration `json:"min_ns"`
+	Median time.Duration `json:"median_ns"`
+	P95    time.Duration `json:"p95_ns"`
+
+	// Average memory allocated by a single run.
+	AllocsPerRun uint64 `json:"allocs_per_run"`
+	BytesPerRun  uint64 `json:"bytes_per_run"`
+
+	// Error returned by the solution, if any.
+	Err string `json:"error,omitempty"`
+}
+
+// Run runs each solution in entries the given number of times against its
+// input in workdir, and returns the results. Solutions without an input are
+// skipped. If progress is not nil, Run writes a line to it before measuring
+// each solution.
+func Run(workdir string, entries []registry.Entry, runs int, progress io.Writer) ([]Result, error) {
+	if runs <= 0 {
+		return nil, fmt.Errorf("invalid number of runs: %d", runs)
+	}
+
+	var results []Result
+
+	for _, e := range entries {
+		input, err := os.ReadFile(filepath.Join(workdir, e.InputFile()))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading input of %s: %w", e.Key, err)
+		}
+
+		if progress != nil {
+			fmt.Fprintf(progress, "⏱️  Benchmarking %s\n", e.Key)
+		}
+
+		results = append(results, measure(e, input, runs))
+	}
+
+	return results, nil
+}
+
+// measure runs e's solution against input the given number of times.
+func measure(e registry.Entry, input []byte, runs int) Result {
+	result := Result{
+		Key:     e.Key,
+		Samples: make([]time.Duration, 0, runs),
+	}
+
+	r := bytes.NewReader(input)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	for n := 0; n < runs; n++ {
+		r.Reset(input)
+
+		start := time.Now()
+		err := e.Solution.Solve(r, io.Discard)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			result.Err = err.Error()
+			break
+		}
+
+		result.Samples = append(result.Samples, elapsed)
+	}
+
+	runtime.ReadMemStats(&after)
+
+	if len(result.Samples) == 0 {
+		return result
+	}
+
+	result.AllocsPerRun = (after.Mallocs - before.Mallocs) / uint64(len(result.Samples))
+	result.BytesPerRun = (after.TotalAlloc - before.TotalAlloc) / uint64(len(result.Samples))
+
+	sorted := make([]time.Duration, len(result.Samples))
+	copy(sorted, result.Samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	result.Min = sorted[0]
+	result.Median = percentile(sorted, 0.50)
+	result.P95 = percentile(sorted, 0.95)
+
+	return result
+}
+
+// percentile returns the p-th percentile of sorted, using the nearest-rank
+// method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,99 @@
+package benchmark
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/helpers"
+	"github.com/busser/adventofcode/registry"
+)
+
+func ExampleWriteReport() {
+	results := []Result{
+		{Key: registry.Key{Year: 2023, Day: 1, Part: 1}, Min: 90 * time.Microsecond, Median: 100 * time.Microsecond, P95: 120 * time.Microsecond, AllocsPerRun: 3, BytesPerRun: 4096},
+		{Key: registry.Key{Year: 2023, Day: 1, Part: 2}, Min: 2 * time.Millisecond, Median: 2500 * time.Microsecond, P95: 3 * time.Millisecond, AllocsPerRun: 10, BytesPerRun: 8192},
+		{Key: registry.Key{Year: 2024, Day: 1, Part: 1}, Min: 1 * time.Millisecond, Median: 1 * time.Millisecond, P95: 1 * time.Millisecond},
+	}
+
+	_ = WriteReport(os.Stdout, NewReport(results), FormatMarkdown)
+	// Output:
+	// ### 2023
+	//
+	// | Day | Part | Min | Median | P95 | Allocs/op | B/op |
+	// | --: | ---: | --: | -----: | --: | --------: | ---: |
+	// | 1 | 1 | 90µs | 100µs | 120µs | 3 | 4096 |
+	// | 1 | 2 | 2ms | 2.5ms | 3ms | 10 | 8192 |
+	// | **Total** | | | **2.6ms** | | | |
+	//
+	// ### 2024
+	//
+	// | Day | Part | Min | Median | P95 | Allocs/op | B/op |
+	// | --: | ---: | --: | -----: | --: | --------: | ---: |
+	// | 1 | 1 | 1ms | 1ms | 1ms | 0 | 0 |
+	// | **Total** | | | **1ms** | | | |
+	//
+	// Whole calendar under **4 ms**.
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	testCases := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{p: 0, want: 1},
+		{p: 0.5, want: 5},
+		{p: 0.95, want: 10},
+		{p: 1, want: 10},
+	}
+
+	for _, tc := range testCases {
+		if got := percentile(sorted, tc.p); got != tc.want {
+			t.Errorf("percentile(%v) = %v, want %v", tc.p, got, tc.want)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	workdir := t.TempDir()
+	withInput := registry.Key{Year: 2024, Day: 1, Part: 1}
+	withoutInput := registry.Key{Year: 2024, Day: 2, Part: 1}
+
+	if err := os.MkdirAll(filepath.Join(workdir, "y2024", "d01", "testdata"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(workdir, withInput.InputFile()), []byte("input"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	solution := helpers.SolutionFunc(func(r io.Reader, w io.Writer) error {
+		calls++
+		_, err := io.Copy(w, r)
+		return err
+	})
+
+	entries := []registry.Entry{
+		{Key: withInput, Solution: solution},
+		{Key: withoutInput, Solution: solution},
+	}
+
+	results, err := Run(workdir, entries, 5, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Key != withInput {
+		t.Errorf("expected result for %s, got %s", withInput, results[0].Key)
+	}
+	if len(results[0].Samples) != 5 || calls != 5 {
+		t.Errorf("expected 5 runs, got %d samples and %d calls", len(results[0].Samples), calls)
+	}
+}
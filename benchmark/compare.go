@@ -0,0 +1,177 @@
+package benchmark
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/busser/adventofcode/registry"
+)
+
+// A Comparison describes how the performance of a solution changed between
+// two runs.
+type Comparison struct {
+	registry.Key
+
+	// Median durations in the old and new runs.
+	Old, New time.Duration
+
+	// Relative change of the median duration, where 0.1 means 10% slower.
+	Delta float64
+
+	// Probability that the samples of both runs come from the same
+	// distribution, according to a Mann-Whitney U test.
+	PValue float64
+
+	// Whether the change is statistically significant.
+	Significant bool
+
+	// Whether the solution got significantly slower than allowed.
+	Regression bool
+}
+
+// Compare compares the results of solutions benchmarked in both base and head. A
+// change is significant if its p-value is below alpha, and a significant
+// slowdown is a regression if it exceeds threshold, where 0.1 means 10%.
+func Compare(base, head Record, alpha, threshold float64) []Comparison {
+	oldResults := make(map[registry.Key]Result)
+	for _, r := range base.Results {
+		oldResults[r.Key] = r
+	}
+
+	var comparisons []Comparison
+
+	for _, n := range head.Results {
+		o, ok := oldResults[n.Key]
+		if !ok || o.Err != "" || n.Err != "" || o.Median == 0 {
+			continue
+		}
+
+		c := Comparison{
+			Key:    n.Key,
+			Old:    o.Median,
+			New:    n.Median,
+			Delta:  float64(n.Median-o.Median) / float64(o.Median),
+			PValue: mannWhitneyU(o.Samples, n.Samples),
+		}
+		c.Significant = c.PValue < alpha
+		c.Regression = c.Significant && c.Delta > threshold
+
+		comparisons = append(comparisons, c)
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool {
+		a, b := comparisons[i].Key, comparisons[j].Key
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Part < b.Part
+	})
+
+	return comparisons
+}
+
+// Regressions returns the comparisons that are regressions.
+func Regressions(comparisons []Comparison) []Comparison {
+	var regressions []Comparison
+	for _, c := range comparisons {
+		if c.Regression {
+			regressions = append(regressions, c)
+		}
+	}
+	return regressions
+}
+
+// WriteComparison writes a table of comparisons between base and head to w.
+// Changes that are not statistically significant are shown as "~".
+func WriteComparison(w io.Writer, base, head Record, comparisons []Comparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(tw, "Solution\t%s\t%s\tDelta\tp-value\t\t\n", base.Label(), head.Label())
+	for _, c := range comparisons {
+		delta := "~"
+		if c.Significant {
+			delta = fmt.Sprintf("%+.1f%%", c.Delta*100)
+		}
+
+		mark := ""
+		if c.Regression {
+			mark = "🔥"
+		}
+
+		fmt.Fprintf(tw, "%d/%02d/%d\t%s\t%s\t%s\t%.3f\t%s\t\n",
+			c.Year, c.Day, c.Part,
+			FormatDuration(c.Old), FormatDuration(c.New),
+			delta, c.PValue, mark,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// mannWhitneyU returns the two-sided p-value of a Mann-Whitney U test on
+// samples x and y, using a normal approximation with corrections for ties and
+// continuity. This is the test benchstat uses to decide whether a difference
+// between benchmarks is significant.
+func mannWhitneyU(x, y []time.Duration) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type sample struct {
+		value time.Duration
+		fromX bool
+	}
+
+	all := make([]sample, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, sample{v, true})
+	}
+	for _, v := range y {
+		all = append(all, sample{v, false})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].value < all[j].value })
+
+	// Assign ranks, averaging them across ties.
+	var rankSumX, tieCorrection float64
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].value == all[i].value {
+			j++
+		}
+
+		rank := float64(i+j+1) / 2 // average of ranks i+1 through j
+		for k := i; k < j; k++ {
+			if all[k].fromX {
+				rankSumX += rank
+			}
+		}
+
+		t := float64(j - i)
+		tieCorrection += t*t*t - t
+
+		i = j
+	}
+
+	n := float64(n1 + n2)
+	u := rankSumX - float64(n1*(n1+1))/2
+	mean := float64(n1*n2) / 2
+	variance := float64(n1*n2) / 12 * ((n + 1) - tieCorrection/(n*(n-1)))
+	if variance <= 0 {
+		return 1
+	}
+
+	z := (math.Abs(u-mean) - 0.5) / math.Sqrt(variance)
+	if z < 0 {
+		z = 0
+	}
+
+	return math.Erfc(z / math.Sqrt2)
+}
@@ -0,0 +1,96 @@
+package benchmark
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/registry"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	testCases := []struct {
+		name string
+		x, y []time.Duration
+		want float64
+	}{
+		{
+			name: "identical",
+			x:    []time.Duration{5, 5, 5, 5, 5},
+			y:    []time.Duration{5, 5, 5, 5, 5},
+			want: 1,
+		},
+		{
+			name: "disjoint",
+			x:    []time.Duration{1, 2, 3, 4, 5},
+			y:    []time.Duration{6, 7, 8, 9, 10},
+			want: 0.0122,
+		},
+		{
+			name: "interleaved",
+			x:    []time.Duration{1, 3, 5, 7, 9},
+			y:    []time.Duration{2, 4, 6, 8, 10},
+			want: 0.6761,
+		},
+		{
+			name: "empty",
+			x:    nil,
+			y:    []time.Duration{1, 2, 3},
+			want: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mannWhitneyU(tc.x, tc.y)
+			if math.Abs(got-tc.want) > 0.0001 {
+				t.Errorf("got p-value %.4f, want %.4f", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	fast := []time.Duration{10, 11, 12, 10, 11, 12, 10, 11, 12, 10}
+	slow := []time.Duration{20, 21, 22, 20, 21, 22, 20, 21, 22, 20}
+
+	stable := registry.Key{Year: 2024, Day: 1, Part: 1}
+	slower := registry.Key{Year: 2024, Day: 1, Part: 2}
+	faster := registry.Key{Year: 2024, Day: 2, Part: 1}
+	removed := registry.Key{Year: 2024, Day: 2, Part: 2}
+
+	base := Record{Results: []Result{
+		{Key: stable, Samples: fast, Median: 11},
+		{Key: slower, Samples: fast, Median: 11},
+		{Key: faster, Samples: slow, Median: 21},
+		{Key: removed, Samples: slow, Median: 21},
+	}}
+	head := Record{Results: []Result{
+		{Key: faster, Samples: fast, Median: 11},
+		{Key: slower, Samples: slow, Median: 21},
+		{Key: stable, Samples: fast, Median: 11},
+	}}
+
+	comparisons := Compare(base, head, 0.05, 0.1)
+
+	if len(comparisons) != 3 {
+		t.Fatalf("expected 3 comparisons, got %d", len(comparisons))
+	}
+
+	want := map[registry.Key]struct{ significant, regression bool }{
+		stable: {false, false},
+		slower: {true, true},
+		faster: {true, false},
+	}
+	for _, c := range comparisons {
+		w := want[c.Key]
+		if c.Significant != w.significant || c.Regression != w.regression {
+			t.Errorf("%s: got significant=%t regression=%t, want significant=%t regression=%t",
+				c.Key, c.Significant, c.Regression, w.significant, w.regression)
+		}
+	}
+
+	if regressions := Regressions(comparisons); len(regressions) != 1 || regressions[0].Key != slower {
+		t.Errorf("expected only %s to regress, got %v", slower, regressions)
+	}
+}
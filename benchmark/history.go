@@ -0,0 +1,154 @@
+package benchmark
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultHistoryFile is the path of the benchmark history, relative to the
+// working directory.
+var DefaultHistoryFile = filepath.Join(".adventofcode", "bench-history.jsonl")
+
+// A Record is a set of benchmark results from a single run of the benchmarks.
+type Record struct {
+	// The git commit the benchmarks ran against, and whether the working tree
+	// had uncommitted changes.
+	Commit string `json:"commit"`
+	Dirty  bool   `json:"dirty,omitempty"`
+
+	Time    time.Time `json:"time"`
+	Results []Result  `json:"results"`
+}
+
+// NewRecord records results against the git commit currently checked out in
+// workdir.
+func NewRecord(workdir string, results []Result) Record {
+	commit, dirty := currentCommit(workdir)
+	return Record{
+		Commit:  commit,
+		Dirty:   dirty,
+		Time:    time.Now().UTC(),
+		Results: results,
+	}
+}
+
+// Label returns a short human-readable identifier of r.
+func (r Record) Label() string {
+	label := r.Commit
+	if len(label) > 12 {
+		label = label[:12]
+	}
+	if r.Dirty {
+		label += "-dirty"
+	}
+	return label
+}
+
+// AppendHistory adds run to the history file at path, creating the file if
+// necessary.
+func AppendHistory(path string, run Record) error {
+	line, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("encoding run: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %w", filepath.Dir(path), err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening history file %q: %w", path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing to history file %q: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+// ReadHistory returns all runs in the history file at path, oldest first.
+func ReadHistory(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening history file %q: %w", path, err)
+	}
+	defer f.Close()
+
+	var runs []Record
+
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 16*1024*1024)
+	for lineNum := 1; s.Scan(); lineNum++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+
+		var run Record
+		if err := json.Unmarshal(s.Bytes(), &run); err != nil {
+			return nil, fmt.Errorf("%s:%d: decoding run: %w", path, lineNum, err)
+		}
+		runs = append(runs, run)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("reading history file %q: %w", path, err)
+	}
+
+	return runs, nil
+}
+
+// FindRecord returns the most recent record in history whose commit starts
+// with ref.
+func FindRecord(history []Record, ref string) (Record, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if ref != "" && strings.HasPrefix(history[i].Commit, ref) {
+			return history[i], true
+		}
+	}
+	return Record{}, false
+}
+
+// ResolveCommit returns the full hash of the git revision ref in workdir. If
+// ref cannot be resolved, it is returned as is, so that it can still match a
+// commit hash prefix.
+func ResolveCommit(workdir, ref string) string {
+	out, err := git(workdir, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil || out == "" {
+		return ref
+	}
+	return out
+}
+
+// currentCommit returns the hash of the commit checked out in workdir and
+// whether the working tree has uncommitted changes. Outside of a git
+// repository, the commit is "unknown".
+func currentCommit(workdir string) (commit string, dirty bool) {
+	commit, err := git(workdir, "rev-parse", "HEAD")
+	if err != nil {
+		return "unknown", false
+	}
+
+	status, err := git(workdir, "status", "--porcelain", "--untracked-files=no")
+	return commit, err == nil && status != ""
+}
+
+func git(workdir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = workdir
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
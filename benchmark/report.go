@@ -0,0 +1,141 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Formats supported by WriteReport.
+const (
+	FormatTable    = "table"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+// A Report summarizes benchmark results, grouped by year.
+type Report struct {
+	Years []YearReport `json:"years"`
+
+	// Sum of the median durations of all solutions.
+	Total time.Duration `json:"total_ns"`
+}
+
+// A YearReport summarizes benchmark results for a single year.
+type YearReport struct {
+	Year    int      `json:"year"`
+	Results []Result `json:"results"`
+
+	// Sum of the median durations of all solutions for the year.
+	Total time.Duration `json:"total_ns"`
+}
+
+// NewReport groups results by year. Results must be sorted by year.
+func NewReport(results []Result) Report {
+	var report Report
+
+	for _, r := range results {
+		if len(report.Years) == 0 || report.Years[len(report.Years)-1].Year != r.Year {
+			report.Years = append(report.Years, YearReport{Year: r.Year})
+		}
+		year := &report.Years[len(report.Years)-1]
+
+		year.Results = append(year.Results, r)
+		year.Total += r.Median
+		report.Total += r.Median
+	}
+
+	return report
+}
+
+// WriteReport writes report to w in the given format.
+func WriteReport(w io.Writer, report Report, format string) error {
+	switch format {
+	case FormatTable:
+		return writeTable(w, report)
+	case FormatJSON:
+		return writeJSON(w, report)
+	case FormatMarkdown:
+		return writeMarkdown(w, report)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeTable(w io.Writer, report Report) error {
+	for _, year := range report.Years {
+		fmt.Fprintf(w, "🎄 %d\n\n", year.Year)
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(tw, "Day\tPart\tMin\tMedian\tP95\tAllocs/op\tB/op\t\n")
+		for _, r := range year.Results {
+			if r.Err != "" {
+				fmt.Fprintf(tw, "%d\t%d\terror: %s\t\t\t\t\t\n", r.Day, r.Part, r.Err)
+				continue
+			}
+			fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%d\t%d\t\n",
+				r.Day, r.Part,
+				FormatDuration(r.Min), FormatDuration(r.Median), FormatDuration(r.P95),
+				r.AllocsPerRun, r.BytesPerRun,
+			)
+		}
+		fmt.Fprintf(tw, "Total\t\t\t%s\t\t\t\t\n", FormatDuration(year.Total))
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	_, err := fmt.Fprintf(w, "🎅🏻 Whole calendar under %s\n", formatMilliseconds(report.Total))
+	return err
+}
+
+func writeJSON(w io.Writer, report Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func writeMarkdown(w io.Writer, report Report) error {
+	for _, year := range report.Years {
+		fmt.Fprintf(w, "### %d\n\n", year.Year)
+		fmt.Fprintln(w, "| Day | Part | Min | Median | P95 | Allocs/op | B/op |")
+		fmt.Fprintln(w, "| --: | ---: | --: | -----: | --: | --------: | ---: |")
+		for _, r := range year.Results {
+			if r.Err != "" {
+				fmt.Fprintf(w, "| %d | %d | error: %s | | | | |\n", r.Day, r.Part, r.Err)
+				continue
+			}
+			fmt.Fprintf(w, "| %d | %d | %s | %s | %s | %d | %d |\n",
+				r.Day, r.Part,
+				FormatDuration(r.Min), FormatDuration(r.Median), FormatDuration(r.P95),
+				r.AllocsPerRun, r.BytesPerRun,
+			)
+		}
+		fmt.Fprintf(w, "| **Total** | | | **%s** | | | |\n\n", FormatDuration(year.Total))
+	}
+
+	_, err := fmt.Fprintf(w, "Whole calendar under **%s**.\n", formatMilliseconds(report.Total))
+	return err
+}
+
+// FormatDuration rounds d to a precision suitable for reading in a table.
+func FormatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Microsecond).String()
+	default:
+		return d.String()
+	}
+}
+
+// formatMilliseconds rounds d up to the next millisecond.
+func formatMilliseconds(d time.Duration) string {
+	ms := (d + time.Millisecond - 1) / time.Millisecond
+	return fmt.Sprintf("%d ms", ms)
+}
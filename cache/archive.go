@@ -0,0 +1,117 @@
+package cache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// maxArchivedFileSize is the size of the largest file Import accepts. Inputs
+// and puzzle descriptions are much smaller than this.
+const maxArchivedFileSize = 16 << 20
+
+// Export writes all entries of the cache to w, as a gzipped tarball. It
+// returns the exported entries.
+func (c *Cache) Export(w io.Writer) ([]Entry, error) {
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	for _, e := range entries {
+		content, ok, err := c.get(e.Key, e.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		header := &tar.Header{
+			Name:    relativePath(e.Key, fileNames[e.Kind]),
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: e.ModTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("archiving %s: %w", header.Name, err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			return nil, fmt.Errorf("archiving %s: %w", header.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("closing archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("closing archive: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Import adds all entries of a gzipped tarball written by Export to the cache,
+// replacing existing entries with the same key and kind. Files of the tarball
+// that are not cache entries are rejected. It returns the imported entries.
+func (c *Cache) Import(r io.Reader) ([]Entry, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+
+	var imported []Entry
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return imported, fmt.Errorf("reading archive: %w", err)
+		}
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+
+		key, kind, ok := parsePath(filepath.ToSlash(filepath.Clean(header.Name)))
+		if !ok || header.Typeflag != tar.TypeReg {
+			return imported, fmt.Errorf("unexpected file %q in archive", header.Name)
+		}
+		if header.Size > maxArchivedFileSize {
+			return imported, fmt.Errorf("file %q in archive is too large", header.Name)
+		}
+
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return imported, fmt.Errorf("reading %q from archive: %w", header.Name, err)
+		}
+		if err := c.put(key, kind, content); err != nil {
+			return imported, err
+		}
+
+		// Keep the original modification time, used when pruning the cache.
+		path, _ := c.path(key, kind)
+		if err := os.Chtimes(path, header.ModTime, header.ModTime); err != nil {
+			return imported, fmt.Errorf("setting modification time of %q: %w", path, err)
+		}
+
+		imported = append(imported, Entry{
+			Key:     key,
+			Kind:    kind,
+			Size:    int64(len(content)),
+			ModTime: header.ModTime,
+		})
+	}
+
+	return imported, nil
+}
@@ -0,0 +1,289 @@
+// Package cache stores inputs and puzzle descriptions downloaded from the
+// Advent of Code website, so that they can be reused across checkouts without
+// downloading them again.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Kinds of content stored in the cache.
+const (
+	Input  = "input"
+	Puzzle = "puzzle"
+)
+
+// fileNames maps each kind of content to the name of its file in the cache.
+var fileNames = map[string]string{
+	Input:  "input.txt",
+	Puzzle: "puzzle.html",
+}
+
+// accountPattern matches valid account names. Account names are used as
+// directory names, so they must not contain path separators.
+var accountPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ValidAccount reports whether name can be used as an account name.
+func ValidAccount(name string) bool {
+	return accountPattern.MatchString(name)
+}
+
+// A Key identifies the content of a puzzle for a given account. Each account
+// has its own input, and sees the second part of a puzzle only once it has
+// solved the first.
+type Key struct {
+	Year    int    `json:"year"`
+	Day     int    `json:"day"`
+	Account string `json:"account"`
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s/%d/%02d", k.Account, k.Year, k.Day)
+}
+
+// An Entry describes content stored in the cache.
+type Entry struct {
+	Key
+	Kind    string    `json:"kind"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// A Cache stores content in a directory. The directory is shared by all
+// accounts, but Get and Put only access content of the cache's account.
+type Cache struct {
+	dir     string
+	account string
+}
+
+// New returns a cache that stores content in dir, and gets and puts content of
+// the given account.
+func New(dir, account string) *Cache {
+	return &Cache{dir: dir, account: account}
+}
+
+// DefaultDir returns the directory of the cache shared by all checkouts of the
+// current user.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "adventofcode"), nil
+}
+
+// Dir returns the directory c stores content in.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// AccountOf returns the name of the account authenticated by a session cookie,
+// derived from a hash of the cookie so that the cookie itself is not stored.
+func AccountOf(cookie string) string {
+	sum := sha256.Sum256([]byte(cookie))
+	return hex.EncodeToString(sum[:6])
+}
+
+// Get returns the content of the given kind stored for the puzzle of the given
+// day. It returns false if there is none.
+func (c *Cache) Get(year, day int, kind string) ([]byte, bool, error) {
+	return c.get(c.key(year, day), kind)
+}
+
+func (c *Cache) get(key Key, kind string) ([]byte, bool, error) {
+	path, err := c.path(key, kind)
+	if err != nil {
+		return nil, false, err
+	}
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	return content, true, nil
+}
+
+// Put stores content of the given kind for the puzzle of the given day,
+// replacing any existing content.
+func (c *Cache) Put(year, day int, kind string, content []byte) error {
+	return c.put(c.key(year, day), kind, content)
+}
+
+func (c *Cache) put(key Key, kind string, content []byte) error {
+	path, err := c.path(key, kind)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %w", filepath.Dir(path), err)
+	}
+
+	// Write to a temporary file first, so that concurrent readers never see
+	// partial content.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("renaming %q: %w", tmp, err)
+	}
+
+	return nil
+}
+
+// List returns all entries of the cache, sorted by account, year, day, and
+// kind.
+func (c *Cache) List() ([]Entry, error) {
+	var entries []Entry
+
+	err := filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && path == c.dir {
+			return fs.SkipDir
+		}
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(c.dir, path)
+		if err != nil {
+			return err
+		}
+		key, kind, ok := parsePath(filepath.ToSlash(rel))
+		if !ok {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, Entry{
+			Key:     key,
+			Kind:    kind,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing cache %q: %w", c.dir, err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Account != b.Account {
+			return a.Account < b.Account
+		}
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Kind < b.Kind
+	})
+
+	return entries, nil
+}
+
+// Prune removes all entries for which remove returns true, and returns them.
+func (c *Cache) Prune(remove func(Entry) bool) ([]Entry, error) {
+	entries, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []Entry
+	for _, e := range entries {
+		if !remove(e) {
+			continue
+		}
+
+		path, err := c.path(e.Key, e.Kind)
+		if err != nil {
+			return removed, err
+		}
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("removing %q: %w", path, err)
+		}
+		removed = append(removed, e)
+
+		// Remove directories left empty, up to the cache's root.
+		for dir := filepath.Dir(path); dir != c.dir; dir = filepath.Dir(dir) {
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+
+	return removed, nil
+}
+
+// key returns the key of the puzzle of the given day for c's account.
+func (c *Cache) key(year, day int) Key {
+	return Key{Year: year, Day: day, Account: c.account}
+}
+
+// Account returns the account whose content c gets and puts.
+func (c *Cache) Account() string {
+	return c.account
+}
+
+// path returns the location of the content of the given kind for key.
+func (c *Cache) path(key Key, kind string) (string, error) {
+	name, ok := fileNames[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown kind of content %q", kind)
+	}
+	if !accountPattern.MatchString(key.Account) {
+		return "", fmt.Errorf("invalid account name %q", key.Account)
+	}
+
+	return filepath.Join(c.dir, filepath.FromSlash(relativePath(key, name))), nil
+}
+
+// relativePath returns the path of a file in the cache, relative to its root.
+func relativePath(key Key, name string) string {
+	return fmt.Sprintf("%s/%d/%02d/%s", key.Account, key.Year, key.Day, name)
+}
+
+var pathPattern = regexp.MustCompile(`^([^/]+)/(\d{4})/(\d{2})/([^/]+)$`)
+
+// parsePath returns the key and kind of the content stored at the given path,
+// relative to the cache's root. It returns false if the path is not one the
+// cache stores content at.
+func parsePath(path string) (Key, string, bool) {
+	match := pathPattern.FindStringSubmatch(path)
+	if match == nil || !accountPattern.MatchString(match[1]) {
+		return Key{}, "", false
+	}
+
+	year, _ := strconv.Atoi(match[2])
+	day, _ := strconv.Atoi(match[3])
+	key := Key{Year: year, Day: day, Account: match[1]}
+
+	for kind, name := range fileNames {
+		if name == match[4] {
+			return key, kind, true
+		}
+	}
+
+	return Key{}, "", false
+}
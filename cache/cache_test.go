@@ -0,0 +1,189 @@
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestGetPut(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir, "alice")
+
+	if _, ok, err := c.Get(2024, 1, Input); err != nil || ok {
+		t.Fatalf("got ok=%v, err=%v from empty cache", ok, err)
+	}
+
+	if err := c.Put(2024, 1, Input, []byte("1 2\n")); err != nil {
+		t.Fatalf("could not store input: %v", err)
+	}
+
+	content, ok, err := c.Get(2024, 1, Input)
+	if err != nil || !ok || string(content) != "1 2\n" {
+		t.Fatalf("got %q, ok=%v, err=%v", content, ok, err)
+	}
+
+	// Content is specific to each account, day, and kind.
+	if _, ok, _ := New(dir, "bob").Get(2024, 1, Input); ok {
+		t.Errorf("input of alice returned for bob")
+	}
+	if _, ok, _ := c.Get(2024, 2, Input); ok {
+		t.Errorf("input of day 1 returned for day 2")
+	}
+	if _, ok, _ := c.Get(2024, 1, Puzzle); ok {
+		t.Errorf("input returned as puzzle")
+	}
+
+	for _, account := range []string{"", "..", "a/b"} {
+		if err := New(dir, account).Put(2024, 1, Input, nil); err == nil {
+			t.Errorf("expected an error for account %q", account)
+		}
+	}
+}
+
+func TestAccountOf(t *testing.T) {
+	a, b := AccountOf("cookie-a"), AccountOf("cookie-b")
+	if a == b {
+		t.Errorf("different cookies share account %q", a)
+	}
+	if !accountPattern.MatchString(a) || a != AccountOf("cookie-a") {
+		t.Errorf("unexpected account %q", a)
+	}
+}
+
+func TestListAndPrune(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir, "")
+
+	keys := []Key{
+		{Year: 2023, Day: 25, Account: "bob"},
+		{Year: 2024, Day: 2, Account: "alice"},
+		{Year: 2024, Day: 1, Account: "alice"},
+	}
+	for _, key := range keys {
+		if err := New(dir, key.Account).Put(key.Year, key.Day, Input, []byte("input")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := New(dir, "alice").Put(2024, 1, Puzzle, []byte("<html></html>")); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unrelated files are ignored.
+	if err := os.WriteFile(filepath.Join(dir, "README"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := c.List()
+	if err != nil {
+		t.Fatalf("could not list cache: %v", err)
+	}
+
+	want := []Entry{
+		{Key: keys[2], Kind: Input, Size: 5},
+		{Key: keys[2], Kind: Puzzle, Size: 13},
+		{Key: keys[1], Kind: Input, Size: 5},
+		{Key: keys[0], Kind: Input, Size: 5},
+	}
+	ignoreTime := cmpopts.IgnoreFields(Entry{}, "ModTime")
+	if diff := cmp.Diff(want, entries, ignoreTime); diff != "" {
+		t.Fatalf("entries mismatch (-want +got):\n%s", diff)
+	}
+
+	removed, err := c.Prune(func(e Entry) bool { return e.Account == "bob" })
+	if err != nil {
+		t.Fatalf("could not prune cache: %v", err)
+	}
+	if diff := cmp.Diff(want[3:], removed, ignoreTime); diff != "" {
+		t.Errorf("removed entries mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "bob")); !os.IsNotExist(err) {
+		t.Errorf("empty directories of pruned entries were not removed")
+	}
+
+	entries, err = c.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[:3], entries, ignoreTime); diff != "" {
+		t.Errorf("entries after pruning mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestListMissingDirectory(t *testing.T) {
+	entries, err := New(filepath.Join(t.TempDir(), "missing"), "").List()
+	if err != nil || len(entries) != 0 {
+		t.Errorf("got %v, %v from missing cache", entries, err)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	src := New(t.TempDir(), "alice")
+	key := Key{Year: 2024, Day: 1, Account: "alice"}
+	if err := src.Put(2024, 1, Input, []byte("1 2\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Put(2024, 1, Puzzle, []byte("<main></main>")); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Date(2024, time.December, 1, 5, 0, 0, 0, time.UTC)
+	path, _ := src.path(key, Input)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	exported, err := src.Export(&archive)
+	if err != nil {
+		t.Fatalf("could not export cache: %v", err)
+	}
+
+	dst := New(t.TempDir(), "alice")
+	imported, err := dst.Import(&archive)
+	if err != nil {
+		t.Fatalf("could not import cache: %v", err)
+	}
+
+	entries, err := dst.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Archives store modification times to the second.
+	equalTime := cmpopts.EquateApproxTime(time.Second)
+	if diff := cmp.Diff(exported, imported, equalTime); diff != "" {
+		t.Errorf("imported entries mismatch (-exported +imported):\n%s", diff)
+	}
+	if diff := cmp.Diff(exported, entries, equalTime); diff != "" {
+		t.Errorf("cache entries mismatch (-exported +listed):\n%s", diff)
+	}
+	if content, _, _ := dst.Get(2024, 1, Input); string(content) != "1 2\n" {
+		t.Errorf("got input %q", content)
+	}
+}
+
+func TestImportRejectsUnexpectedFiles(t *testing.T) {
+	for _, name := range []string{"../evil/2024/01/input.txt", "alice/2024/01/secret.txt", "/etc/passwd"} {
+		var archive bytes.Buffer
+		gz := gzip.NewWriter(&archive)
+		tw := tar.NewWriter(gz)
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: 1, Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte("x"))
+		tw.Close()
+		gz.Close()
+
+		dir := t.TempDir()
+		if _, err := New(dir, "").Import(&archive); err == nil {
+			t.Errorf("expected an error importing %q", name)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+// Formats supported by WriteEntries.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// WriteEntries writes a list of entries to w, in the given format.
+func WriteEntries(w io.Writer, entries []Entry, format string) error {
+	switch format {
+	case FormatText:
+		return writeEntriesText(w, entries)
+	case FormatJSON:
+		if entries == nil {
+			entries = []Entry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeEntriesText(w io.Writer, entries []Entry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ACCOUNT\tYEAR\tDAY\tKIND\tSIZE\tMODIFIED")
+
+	var total int64
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\n",
+			e.Account, e.Year, e.Day, e.Kind, formatSize(e.Size), e.ModTime.Format(time.DateTime))
+		total += e.Size
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d entries, %s\n", len(entries), formatSize(total))
+	return err
+}
+
+// formatSize returns a human-readable representation of a number of bytes.
+func formatSize(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
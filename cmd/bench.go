@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/busser/adventofcode/benchmark"
+	"github.com/busser/adventofcode/registry"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// benchCmd represents the bench command
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Measure the performance of solutions",
+	Long: `Measure the performance of solutions against their inputs.
+
+Every registered solution is run several times against the input found in its
+package's testdata directory. Solutions without an input are skipped.
+
+Examples:
+  # Benchmark the whole calendar.
+  adventofcode bench
+
+  # Benchmark all solutions of a single year, as a Markdown table.
+  adventofcode bench --year=2024 --format=markdown
+
+  # Benchmark a single day, with more runs for more accurate results.
+  adventofcode bench --year=2024 --day=17 --runs=100
+
+Results are appended to a history file, keyed by git commit, so that runs can
+later be compared with 'adventofcode bench compare'.`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries := selectEntries(viper.GetInt("year"), viper.GetInt("day"))
+		if len(entries) == 0 {
+			return fmt.Errorf("no matching solutions")
+		}
+
+		workdir := viper.GetString("workdir")
+
+		results, err := benchmark.Run(workdir, entries, viper.GetInt("runs"), os.Stderr)
+		if err != nil {
+			return fmt.Errorf("running benchmarks: %w", err)
+		}
+
+		if viper.GetBool("save") {
+			path := historyFile(workdir)
+			if err := benchmark.AppendHistory(path, benchmark.NewRecord(workdir, results)); err != nil {
+				return fmt.Errorf("saving results: %w", err)
+			}
+			fmt.Fprintln(os.Stderr, "💾 Saved results to", path)
+		}
+
+		report := benchmark.NewReport(results)
+		if err := benchmark.WriteReport(os.Stdout, report, viper.GetString("format")); err != nil {
+			return fmt.Errorf("writing report: %w", err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(benchCmd)
+
+	benchCmd.Flags().IntP("year", "y", 0, "Only benchmark solutions for this year")
+	benchCmd.Flags().IntP("day", "d", 0, "Only benchmark solutions for this day")
+	benchCmd.Flags().IntP("runs", "n", 10, "Number of times to run each solution")
+	benchCmd.Flags().StringP("format", "o", benchmark.FormatTable, "Output format: table, json, or markdown")
+	benchCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+	benchCmd.Flags().Bool("save", true, "If true, append results to the benchmark history")
+	benchCmd.Flags().String("history", "", "Benchmark history file (default is .adventofcode/bench-history.jsonl in the working directory)")
+
+	_ = benchCmd.RegisterFlagCompletionFunc("year", completeRegisteredYears)
+	_ = benchCmd.RegisterFlagCompletionFunc("day", completeRegisteredDays)
+}
+
+// historyFile returns the path to the benchmark history.
+func historyFile(workdir string) string {
+	if path := viper.GetString("history"); path != "" {
+		return path
+	}
+	return filepath.Join(workdir, benchmark.DefaultHistoryFile)
+}
+
+// selectEntries returns registered solutions matching year and day. A zero
+// value matches everything.
+func selectEntries(year, day int) []registry.Entry {
+	var selected []registry.Entry
+	for _, e := range registry.All() {
+		if year != 0 && e.Year != year {
+			continue
+		}
+		if day != 0 && e.Day != day {
+			continue
+		}
+		selected = append(selected, e)
+	}
+	return selected
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/benchmark"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// benchCompareCmd represents the bench compare command
+var benchCompareCmd = &cobra.Command{
+	Use:   "compare [old] [new]",
+	Short: "Compare two benchmark runs and detect regressions",
+	Long: `Compare two benchmark runs from the history and detect regressions.
+
+Runs are identified by git revision or commit hash prefix. By default, the two
+most recent runs are compared. If a single revision is provided, it is compared
+to the most recent run.
+
+A change is considered significant when a Mann-Whitney U test on the samples of
+both runs gives a p-value below '--alpha'. The command fails if any solution
+got significantly slower by more than '--threshold' percent.
+
+Examples:
+  # Compare the two most recent runs.
+  adventofcode bench compare
+
+  # Compare the main branch to the most recent run.
+  adventofcode bench compare main
+
+  # Compare two commits, tolerating slowdowns of up to 25%.
+  adventofcode bench compare 1a2b3c 4d5e6f --threshold=25`,
+	Args: cobra.MaximumNArgs(2),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workdir := viper.GetString("workdir")
+
+		history, err := benchmark.ReadHistory(historyFile(workdir))
+		if err != nil {
+			return err
+		}
+
+		base, head, err := selectRuns(workdir, history, args)
+		if err != nil {
+			return err
+		}
+
+		comparisons := benchmark.Compare(base, head, viper.GetFloat64("alpha"), viper.GetFloat64("threshold")/100)
+		if err := benchmark.WriteComparison(os.Stdout, base, head, comparisons); err != nil {
+			return fmt.Errorf("writing comparison: %w", err)
+		}
+
+		if regressions := benchmark.Regressions(comparisons); len(regressions) > 0 {
+			return fmt.Errorf("%d solution(s) regressed by more than %g%%", len(regressions), viper.GetFloat64("threshold"))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	benchCmd.AddCommand(benchCompareCmd)
+
+	benchCompareCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+	benchCompareCmd.Flags().String("history", "", "Benchmark history file (default is .adventofcode/bench-history.jsonl in the working directory)")
+	benchCompareCmd.Flags().Float64("threshold", 10, "Maximum tolerated slowdown, in percent")
+	benchCompareCmd.Flags().Float64("alpha", 0.05, "Significance level of changes")
+}
+
+// selectRuns picks the two runs to compare from history, based on the
+// revisions provided as arguments.
+func selectRuns(workdir string, history []benchmark.Record, refs []string) (base, head benchmark.Record, err error) {
+	find := func(ref string) (benchmark.Record, error) {
+		run, ok := benchmark.FindRecord(history, benchmark.ResolveCommit(workdir, ref))
+		if !ok {
+			return benchmark.Record{}, fmt.Errorf("no benchmark run found for %q", ref)
+		}
+		return run, nil
+	}
+
+	switch len(refs) {
+	case 0:
+		if len(history) < 2 {
+			return base, head, errors.New("history contains fewer than two runs")
+		}
+		return history[len(history)-2], history[len(history)-1], nil
+	case 1:
+		if len(history) == 0 {
+			return base, head, errors.New("history is empty")
+		}
+		base, err = find(refs[0])
+		return base, history[len(history)-1], err
+	default:
+		if base, err = find(refs[0]); err != nil {
+			return base, head, err
+		}
+		head, err = find(refs[1])
+		return base, head, err
+	}
+}
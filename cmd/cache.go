@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// cacheCmd represents the cache command
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the cache of inputs and puzzles",
+	Long: `Manage the cache of inputs and puzzles.
+
+Inputs and puzzle descriptions downloaded by the scaffold command are cached in
+your user cache directory, so that other checkouts of your solutions do not
+need to download them again. Content is cached per account, since each account
+has its own input. Accounts are named after a hash of their session cookie,
+unless you name them with the '--account' flag.
+
+The cache can be exported to a tarball and imported elsewhere, for example to
+scaffold solutions on a machine without network access:
+
+  adventofcode cache export cache.tar.gz
+  adventofcode cache import cache.tar.gz
+  adventofcode scaffold --all --account=<account>`,
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cacheExportCmd represents the cache export command
+var cacheExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Export the cache to a tarball",
+	Long: `Export the content of the cache, of all accounts, to a gzipped tarball.
+
+Use '-' as the file to write the tarball to standard output.
+
+Examples:
+  # Export the cache to a file.
+  adventofcode cache export cache.tar.gz
+
+  # Copy the cache to another machine.
+  adventofcode cache export - | ssh ci.example.com adventofcode cache import -`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := newCache()
+		if err != nil {
+			return err
+		}
+		if store == nil {
+			return fmt.Errorf("cache is disabled")
+		}
+
+		if args[0] == "-" {
+			entries, err := store.Export(os.Stdout)
+			if err != nil {
+				return fmt.Errorf("exporting cache: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "📦 Exported %d entries from the cache.\n", len(entries))
+			return nil
+		}
+
+		f, err := os.Create(args[0])
+		if err != nil {
+			return fmt.Errorf("creating %q: %w", args[0], err)
+		}
+		defer f.Close()
+
+		entries, err := store.Export(f)
+		if err != nil {
+			return fmt.Errorf("exporting cache: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("writing %q: %w", args[0], err)
+		}
+
+		fmt.Printf("📦 Exported %d entries from the cache.\n", len(entries))
+
+		return nil
+	},
+}
+
+// cacheImportCmd represents the cache import command
+var cacheImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import a tarball into the cache",
+	Long: `Import the content of a tarball written by 'adventofcode cache export' into
+the cache. Existing content with the same account, day, and kind is replaced.
+
+Use '-' as the file to read the tarball from standard input.
+
+Examples:
+  # Import the cache from a file.
+  adventofcode cache import cache.tar.gz`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := newCache()
+		if err != nil {
+			return err
+		}
+		if store == nil {
+			return fmt.Errorf("cache is disabled")
+		}
+
+		r, err := openInput(args[0])
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		entries, err := store.Import(r)
+		if err != nil {
+			return fmt.Errorf("importing cache: %w", err)
+		}
+
+		fmt.Printf("📦 Imported %d entries into the cache.\n", len(entries))
+
+		return nil
+	},
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheExportCmd)
+	cacheCmd.AddCommand(cacheImportCmd)
+}
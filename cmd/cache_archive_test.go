@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/busser/adventofcode/cache"
+)
+
+func TestCacheExportImport(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	source := t.TempDir()
+	if err := cache.New(source, "alice").Put(2024, 1, cache.Input, []byte("42\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := filepath.Join(t.TempDir(), "cache.tar.gz")
+	rootCmd.SetArgs([]string{"cache", "export", archive, "--cache-dir", source})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("exporting cache: %v", err)
+	}
+
+	destination := t.TempDir()
+	rootCmd.SetArgs([]string{"cache", "import", archive, "--cache-dir", destination})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("importing cache: %v", err)
+	}
+
+	input, ok, err := cache.New(destination, "alice").Get(2024, 1, cache.Input)
+	if err != nil || !ok {
+		t.Fatalf("input not imported: %v", err)
+	}
+	if string(input) != "42\n" {
+		t.Errorf("got input %q, want %q", input, "42\n")
+	}
+}
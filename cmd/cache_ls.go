@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/cache"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cacheLsCmd represents the cache ls command
+var cacheLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List the content of the cache",
+	Long: `List the inputs and puzzle descriptions in the cache, of all accounts.
+
+Examples:
+  # List the content of the cache.
+  adventofcode cache ls
+
+  # List the content of the cache, as JSON.
+  adventofcode cache ls --format=json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := newCache()
+		if err != nil {
+			return err
+		}
+		if store == nil {
+			return fmt.Errorf("cache is disabled")
+		}
+
+		entries, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		format := viper.GetString("format")
+		if format == cache.FormatText {
+			fmt.Printf("🗄️  Cache directory: %s\n\n", store.Dir())
+		}
+
+		return cache.WriteEntries(os.Stdout, entries, format)
+	},
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheLsCmd)
+
+	cacheLsCmd.Flags().StringP("format", "o", cache.FormatText, "Output format: text or json")
+}
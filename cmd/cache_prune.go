@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/busser/adventofcode/cache"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cachePruneCmd represents the cache prune command
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove content from the cache",
+	Long: `Remove inputs and puzzle descriptions from the cache.
+
+By default, all content of your account is removed. Use flags to only remove
+some of it, or to remove content of all accounts.
+
+Examples:
+  # Remove all content of your account.
+  adventofcode cache prune
+
+  # Remove content older than 30 days, of all accounts.
+  adventofcode cache prune --older-than=720h --all-accounts
+
+  # Remove puzzle descriptions of 2015.
+  adventofcode cache prune --year=2015 --kind=puzzle`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := newCache()
+		if err != nil {
+			return err
+		}
+		if store == nil {
+			return fmt.Errorf("cache is disabled")
+		}
+
+		var (
+			olderThan   = viper.GetDuration("older-than")
+			year        = viper.GetInt("year")
+			kind        = viper.GetString("kind")
+			allAccounts = viper.GetBool("all-accounts")
+			now         = time.Now()
+		)
+
+		removed, err := store.Prune(func(e cache.Entry) bool {
+			return (allAccounts || e.Account == store.Account()) &&
+				(olderThan == 0 || now.Sub(e.ModTime) > olderThan) &&
+				(year == 0 || e.Year == year) &&
+				(kind == "" || e.Kind == kind)
+		})
+		for _, e := range removed {
+			fmt.Printf("  👉 Removed %s of %s.\n", e.Kind, e.Key)
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("🧹 Removed %d entries from the cache.\n", len(removed))
+
+		return nil
+	},
+}
+
+func init() {
+	cacheCmd.AddCommand(cachePruneCmd)
+
+	cachePruneCmd.Flags().Duration("older-than", 0, "Only remove content cached longer ago than this")
+	cachePruneCmd.Flags().IntP("year", "y", 0, "Only remove content of this year")
+	cachePruneCmd.Flags().String("kind", "", "Only remove content of this kind: input or puzzle")
+	cachePruneCmd.Flags().Bool("all-accounts", false, "If true, remove content of all accounts instead of only yours")
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/busser/adventofcode/workspace"
+	"github.com/spf13/cobra"
+)
+
+// completeRegisteredYears suggests years with registered solutions.
+func completeRegisteredYears(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var years []string
+	for _, e := range registry.All() {
+		year := strconv.Itoa(e.Year)
+		if len(years) == 0 || years[len(years)-1] != year {
+			years = append(years, year)
+		}
+	}
+	return years, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRegisteredDays suggests days with registered solutions, in the year
+// given by the command's --year flag, or in all years if it is zero.
+func completeRegisteredDays(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	year, _ := cmd.Flags().GetInt("year")
+
+	var days []int
+	parts := make(map[int][]string)
+	for _, e := range registry.All() {
+		if year != 0 && e.Year != year {
+			continue
+		}
+		if _, ok := parts[e.Day]; !ok {
+			days = append(days, e.Day)
+		}
+		parts[e.Day] = append(parts[e.Day], strconv.Itoa(e.Part))
+	}
+
+	var suggestions []string
+	for _, day := range days {
+		if year == 0 {
+			suggestions = append(suggestions, strconv.Itoa(day))
+			continue
+		}
+		suggestions = append(suggestions, fmt.Sprintf("%d\tparts %s", day, strings.Join(parts[day], ", ")))
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeScaffoldYears suggests years with packages in the working directory
+// when overwriting them, and all years of Advent of Code otherwise.
+func completeScaffoldYears(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var years []string
+
+	if force, _ := cmd.Flags().GetBool("force"); force {
+		scanned, err := workspace.Scan(completionWorkdir(cmd))
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		for _, y := range scanned {
+			years = append(years, strconv.Itoa(y.Year))
+		}
+		return years, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for year := 2015; year <= latestYear(); year++ {
+		years = append(years, strconv.Itoa(year))
+	}
+	return years, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeScaffoldDays suggests days of the year given by the --year flag
+// that have a package in the working directory when overwriting them, and
+// days that have none otherwise.
+func completeScaffoldDays(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	year, _ := cmd.Flags().GetInt("year")
+	force, _ := cmd.Flags().GetBool("force")
+
+	scanned, err := workspace.ScanYear(completionWorkdir(cmd), year)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	var days []string
+	for _, d := range scanned.Days {
+		if d.HasSolution == force {
+			days = append(days, strconv.Itoa(d.Day))
+		}
+	}
+	return days, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionWorkdir returns the working directory given by the command's
+// --workdir flag.
+func completionWorkdir(cmd *cobra.Command) string {
+	workdir, _ := cmd.Flags().GetString("workdir")
+	if workdir == "" {
+		return "."
+	}
+	return workdir
+}
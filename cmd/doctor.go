@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/workspace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that solutions follow the repository's conventions",
+	Long: `Check that solutions follow the repository's conventions.
+
+Every yYYYY/dDD package is analysed, and each violation is reported with its
+file and line:
+
+  syntax           a Go file does not parse
+  package-name     a package is not named after its directory
+  benchmark        a package has no benchmark
+  example-answer   an implemented part has no example, or its answer is unknown
+  input            an input is empty, or has CRLF line endings
+
+With '--fix', mechanical violations are fixed: packages are renamed, missing
+benchmarks are added, and line endings are converted. The command fails if any
+violation remains.
+
+Examples:
+  # Check all solutions.
+  adventofcode doctor
+
+  # Fix what can be fixed.
+  adventofcode doctor --fix`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workdir := viper.GetString("workdir")
+		format := viper.GetString("format")
+
+		issues, err := workspace.Diagnose(workdir)
+		if err != nil {
+			return fmt.Errorf("checking working directory: %w", err)
+		}
+
+		if viper.GetBool("fix") {
+			fixed, err := workspace.Fix(workdir, issues)
+			for _, issue := range fixed {
+				fmt.Fprintf(os.Stderr, "🔧 Fixed %s\n", issue)
+			}
+			if err != nil {
+				return err
+			}
+
+			// Fixes may reveal other issues, like examples of a package that
+			// did not parse.
+			issues, err = workspace.Diagnose(workdir)
+			if err != nil {
+				return fmt.Errorf("checking working directory: %w", err)
+			}
+		}
+
+		if err := workspace.WriteIssues(os.Stdout, issues, format); err != nil {
+			return err
+		}
+
+		if len(issues) > 0 {
+			fixable := 0
+			for _, issue := range issues {
+				if issue.Fixable {
+					fixable++
+				}
+			}
+			if fixable > 0 {
+				return fmt.Errorf("found %d issues, %d of which can be fixed with --fix", len(issues), fixable)
+			}
+			return fmt.Errorf("found %d issues", len(issues))
+		}
+
+		if format == workspace.FormatText {
+			fmt.Println("🩺 No issues found")
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().Bool("fix", false, "If true, fix issues that can be fixed automatically")
+	doctorCmd.Flags().StringP("format", "o", workspace.FormatText, "Output format: text or json")
+	doctorCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+}
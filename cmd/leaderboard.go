@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/busser/adventofcode/leaderboard"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// leaderboardCmd represents the leaderboard command
+var leaderboardCmd = &cobra.Command{
+	Use:   "leaderboard",
+	Short: "Show a private leaderboard",
+	Long: `Show a private leaderboard, with the stars and local score of each member.
+
+The leaderboard is downloaded with your session cookie, which must belong to a
+member of the leaderboard. Its ID is the number at the end of the leaderboard's
+address. The website asks that leaderboards be downloaded at most once every 15
+minutes, so the command saves each leaderboard in the .adventofcode directory
+and reuses it until then.
+
+With '--file', the command reads a leaderboard saved as JSON instead, and does
+not need a cookie.
+
+With '--day', the command also shows how long after the puzzle unlocked each
+member solved it.
+
+Members can be sorted by local score, stars, last star, name, or by time to
+solve the day given with '--day'.
+
+Examples:
+  # Show a leaderboard of this year.
+  adventofcode leaderboard --id=123456
+
+  # Show who solved day 5 the fastest.
+  adventofcode leaderboard --id=123456 --day=5 --sort=time
+
+  # Show a leaderboard saved from the website.
+  adventofcode leaderboard --file=leaderboard.json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, year, day := viper.GetInt("id"), viper.GetInt("year"), viper.GetInt("day")
+
+		var lb *leaderboard.Leaderboard
+		if file := viper.GetString("file"); file != "" {
+			var err error
+			lb, err = leaderboard.ReadFile(file)
+			if err != nil {
+				return err
+			}
+		} else {
+			if id == 0 {
+				return errors.New("one of --id and --file is required")
+			}
+			if sessionCookie() == "" {
+				return errors.New("a session cookie is required to download a leaderboard")
+			}
+
+			path := filepath.Join(viper.GetString("workdir"), leaderboard.SavedFile(year, id))
+			now := time.Now()
+
+			var fetched time.Time
+			var err error
+			lb, fetched, err = leaderboard.Load(newClient(), path, year, id, now)
+			if err != nil {
+				return err
+			}
+			if fetched.Before(now) {
+				fmt.Fprintf(os.Stderr, "📦 Using leaderboard downloaded %s ago\n\n", now.Sub(fetched).Round(time.Second))
+			}
+		}
+
+		if err := lb.Sort(viper.GetString("sort"), day); err != nil {
+			return err
+		}
+
+		return leaderboard.WriteLeaderboard(os.Stdout, lb, viper.GetString("format"), day)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(leaderboardCmd)
+
+	leaderboardCmd.Flags().Int("id", 0, "ID of the private leaderboard")
+	leaderboardCmd.Flags().IntP("year", "y", latestYear(), "The year of Advent of Code to show the leaderboard of")
+	leaderboardCmd.Flags().IntP("day", "d", 0, "Also show when members solved this day")
+	leaderboardCmd.Flags().String("file", "", "Read the leaderboard from this JSON file instead of downloading it")
+	leaderboardCmd.Flags().StringP("sort", "s", leaderboard.ByScore, "Order of members: score, stars, last-star, name, or time")
+	leaderboardCmd.Flags().StringP("format", "o", leaderboard.FormatText, "Output format: text or json")
+	leaderboardCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/busser/adventofcode/workspace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// listCmd represents the list command
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered solutions",
+	Long: `List registered solutions, with their package, the parts they solve, and
+whether their input is present.
+
+Examples:
+  # List all solutions.
+  adventofcode list
+
+  # List solutions of a single year, as JSON for use in scripts.
+  adventofcode list --year=2024 --json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var keys []registry.Key
+		for _, e := range selectEntries(viper.GetInt("year"), 0) {
+			keys = append(keys, e.Key)
+		}
+
+		solutions, err := workspace.ListSolutions(viper.GetString("workdir"), keys)
+		if err != nil {
+			return fmt.Errorf("listing solutions: %w", err)
+		}
+
+		format := workspace.FormatText
+		if viper.GetBool("json") {
+			format = workspace.FormatJSON
+		}
+
+		return workspace.WriteSolutions(os.Stdout, solutions, format)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+
+	listCmd.Flags().IntP("year", "y", 0, "Only list solutions for this year")
+	listCmd.Flags().Bool("json", false, "If true, print solutions as JSON")
+	listCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+
+	_ = listCmd.RegisterFlagCompletionFunc("year", completeRegisteredYears)
+}
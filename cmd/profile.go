@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/busser/adventofcode/cache"
+	"github.com/spf13/viper"
+)
+
+// A profile is an account on adventofcode.com, configured in the "profiles"
+// section of the configuration file:
+//
+//	profiles:
+//	  alice:
+//	    cookie: abcdef0123456789...
+//	    name: Alice
+type profile struct {
+	// Session cookie of the account.
+	Cookie string `mapstructure:"cookie"`
+
+	// Name shown when the profile is used.
+	DisplayName string `mapstructure:"name"`
+}
+
+// profiles returns all profiles of the configuration, keyed by name.
+func profiles() (map[string]profile, error) {
+	var all map[string]profile
+	if err := viper.UnmarshalKey("profiles", &all); err != nil {
+		return nil, fmt.Errorf("reading profiles: %w", err)
+	}
+	return all, nil
+}
+
+// currentProfile returns the name and settings of the profile selected with
+// the "profile" setting. The name is empty if no profile is selected.
+//
+// Profile names are used as directory names for inputs, answers and the
+// cache, so they follow the same rules as account names in the cache.
+func currentProfile() (string, profile, error) {
+	name := viper.GetString("profile")
+	if name == "" {
+		return "", profile{}, nil
+	}
+	if !cache.ValidAccount(name) {
+		return "", profile{}, fmt.Errorf("invalid profile name %q", name)
+	}
+
+	all, err := profiles()
+	if err != nil {
+		return "", profile{}, err
+	}
+
+	p, ok := all[name]
+	if !ok {
+		var names []string
+		for n := range all {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return "", profile{}, fmt.Errorf("unknown profile %q; configured profiles: %v", name, names)
+	}
+
+	return name, p, nil
+}
+
+// sessionCookie returns the session cookie of the selected profile, if it has
+// one, or the "cookie" setting otherwise.
+func sessionCookie() string {
+	if _, p, err := currentProfile(); err == nil && p.Cookie != "" {
+		return p.Cookie
+	}
+	return viper.GetString("cookie")
+}
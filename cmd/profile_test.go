@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestCurrentProfileInvalidName(t *testing.T) {
+	viper.Set("profiles", map[string]any{
+		"../alice": map[string]any{"cookie": "abc"},
+	})
+	viper.Set("profile", "../alice")
+	t.Cleanup(func() {
+		viper.Set("profiles", nil)
+		viper.Set("profile", "")
+	})
+
+	if _, _, err := currentProfile(); err == nil {
+		t.Fatal("currentProfile() accepted an invalid profile name")
+	}
+}
@@ -3,7 +3,10 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/cache"
 	"github.com/spf13/cobra"
 
 	homedir "github.com/mitchellh/go-homedir"
@@ -38,6 +41,18 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.adventofcode.yaml)")
+
+	rootCmd.PersistentFlags().String("base-url", aoc.DefaultBaseURL, "Address of the Advent of Code website")
+	rootCmd.PersistentFlags().String("user-agent", aoc.DefaultUserAgent, "User-Agent header sent to the Advent of Code website")
+	rootCmd.PersistentFlags().Duration("timeout", aoc.DefaultTimeout, "Timeout of requests to the Advent of Code website")
+	rootCmd.PersistentFlags().Int("retries", aoc.DefaultMaxRetries, "How many times to retry downloads when the Advent of Code website fails")
+
+	rootCmd.PersistentFlags().String("profile", "", "Profile of the configuration file to use, for its session cookie and input")
+	_ = viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+
+	rootCmd.PersistentFlags().String("cache-dir", "", "Directory of the cache of inputs and puzzles (default is adventofcode in the user cache directory)")
+	rootCmd.PersistentFlags().String("account", "", "Name of your account in the cache (default is the profile, or derived from the session cookie)")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "If true, do not use the cache of inputs and puzzles")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -65,4 +80,72 @@ func initConfig() {
 	if err := viper.ReadInConfig(); err == nil {
 		fmt.Fprintln(os.Stderr, "⚙️  Using config file:", viper.ConfigFileUsed())
 	}
+
+	name, p, err := currentProfile()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if name != "" {
+		display := name
+		if p.DisplayName != "" {
+			display = fmt.Sprintf("%s (%s)", name, p.DisplayName)
+		}
+		fmt.Fprintln(os.Stderr, "👤 Using profile:", display)
+	}
+}
+
+// latestYear returns the year of the latest Advent of Code, the first puzzle
+// of which has already unlocked.
+func latestYear() int {
+	now := time.Now()
+	year := now.Year()
+	if !aoc.Unlocked(year, 1, now) {
+		year--
+	}
+	return year
+}
+
+// newClient returns a client for the Advent of Code website, configured with
+// the session cookie and connection settings of the CLI.
+func newClient() *aoc.Client {
+	client := aoc.NewClient(sessionCookie())
+	client.BaseURL = viper.GetString("base-url")
+	client.UserAgent = viper.GetString("user-agent")
+	client.MaxRetries = viper.GetInt("retries")
+	client.HTTPClient.Timeout = viper.GetDuration("timeout")
+	return client
+}
+
+// newCache returns the cache of inputs and puzzles shared by all checkouts,
+// for the account of the CLI. It returns nil if the cache is disabled.
+func newCache() (*cache.Cache, error) {
+	if viper.GetBool("no-cache") {
+		return nil, nil
+	}
+
+	dir := viper.GetString("cache-dir")
+	if dir == "" {
+		var err error
+		dir, err = cache.DefaultDir()
+		if err != nil {
+			return nil, fmt.Errorf("finding cache directory: %w", err)
+		}
+	}
+
+	return cache.New(dir, cacheAccount()), nil
+}
+
+// cacheAccount returns the name of the account of the CLI in the cache.
+func cacheAccount() string {
+	if account := viper.GetString("account"); account != "" {
+		return account
+	}
+	if profile := viper.GetString("profile"); profile != "" {
+		return profile
+	}
+	if cookie := sessionCookie(); cookie != "" {
+		return cache.AccountOf(cookie)
+	}
+	return "anonymous"
 }
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// runCmd represents the run command
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a solution against an input",
+	Long: `Run a solution against an input and print the answer.
+
+Examples:
+  # Solve part 1 of day 17 with input read from a file.
+  adventofcode run --year=2024 --day=17 --part=1 --input=input.txt
+
+  # Solve part 2 of day 17 with input read from standard input.
+  cat input.txt | adventofcode run --year=2024 --day=17 --part=2
+
+The CLI assumes you are working on the latest Advent of Code. You can always
+override this behavior with the '--year' flag.`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		year := viper.GetInt("year")
+		day := viper.GetInt("day")
+		part := viper.GetInt("part")
+
+		solution, ok := registry.Lookup(year, day, part)
+		if !ok {
+			return fmt.Errorf("no solution for %d day %d part %d", year, day, part)
+		}
+
+		input, err := openInput(viper.GetString("input"))
+		if err != nil {
+			return err
+		}
+		defer input.Close()
+
+		if err := solution.Solve(input, os.Stdout); err != nil {
+			return fmt.Errorf("solving: %w", err)
+		}
+		fmt.Println()
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+
+	runCmd.Flags().IntP("day", "d", 0, "The day of the puzzle to solve")
+	runCmd.Flags().IntP("year", "y", latestYear(), "The year of the puzzle to solve")
+	runCmd.Flags().IntP("part", "p", 1, "The part of the puzzle to solve")
+	runCmd.Flags().StringP("input", "i", "-", "File to read the input from, or - for standard input")
+
+	_ = runCmd.RegisterFlagCompletionFunc("year", completeRegisteredYears)
+	_ = runCmd.RegisterFlagCompletionFunc("day", completeRegisteredDays)
+}
+
+// openInput opens the file at path for reading. If path is "-", openInput
+// returns standard input.
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening input: %w", err)
+	}
+
+	return f, nil
+}
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
+	"github.com/busser/adventofcode/aoc"
 	"github.com/busser/adventofcode/scaffolding"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -25,28 +28,154 @@ Examples:
   # Provide a session cookie to download your input of the day.
   adventofcode scaffold --day=1 --cookie=abcdef0123...
 
+  # Build scaffolding for the first five days.
+  adventofcode scaffold --days=1-5
+
+  # Build scaffolding for every unlocked day of several years.
+  adventofcode scaffold --all --year-range=2015-2020
+
+  # Wait for the next puzzle to unlock, then build scaffolding for it.
+  adventofcode scaffold --wait
+
 The CLI assumes you are working on the latest Advent of Code. You can always
 override this behavior with the '--year' flag.
 
 To download your input, provide the value of the 'session' cookie for the
 adventofcode.com website. You can do this with the '--cookie' flag, the
 ADVENTOFCODE_COOKIE environment variable, or by setting the 'cookie' field in
-your configuration file.`,
+your configuration file.
+
+When scaffolding several days, packages that already have a solution and an
+input are skipped, and requests to adventofcode.com are spaced out by the
+'--delay' flag. A summary of created, skipped, and failed days is printed at
+the end.
+
+With '--wait', the command counts down to the moment the puzzle unlocks, at
+midnight UTC-5, then builds scaffolding right away. Without '--day', it waits
+for the next puzzle to unlock. If the puzzle is not available yet when the
+countdown ends, the command keeps trying for a couple of minutes.
+
+Inputs and puzzle descriptions are cached in your user cache directory, so that
+other checkouts do not need to download them again. See 'adventofcode cache
+--help' for details.
+
+Files are generated from templates, written with Go's text/template package.
+To customize them, point the '--templates' flag or the 'templates' field of
+your configuration file at a directory of templates. Each file named
+<path>.tmpl in that directory renders to <path> in the scaffolded package,
+replacing the default template of the same name if there is one.`,
 	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		gen, err := scaffolding.NewGenerator(
-			viper.GetInt("day"),
-			viper.GetInt("year"),
+		targets, err := scaffoldTargets(time.Now())
+		if err != nil {
+			return err
+		}
+
+		client := newClient()
+
+		store, err := newCache()
+		if err != nil {
+			return err
+		}
+
+		if viper.GetBool("wait") {
+			target := targets[0]
+			scaffolding.WaitForUnlock(scaffolding.SystemClock, target.Year, target.Day, os.Stdout)
+
+			gen, err := scaffolding.NewGenerator(
+				target.Day,
+				target.Year,
+				viper.GetString("workdir"),
+				viper.GetString("templates"),
+				viper.GetString("profile"),
+				client,
+				store,
+				viper.GetBool("force"),
+			)
+			if err != nil {
+				return fmt.Errorf("making code generator: %w", err)
+			}
+
+			// Scaffolding does not need the puzzle, but it is worth waiting for
+			// when adventofcode.com is slow to serve it. Run reports if it never
+			// comes.
+			_ = scaffolding.RetryWhileLocked(
+				scaffolding.SystemClock,
+				scaffolding.DefaultUnlockRetryWindow,
+				scaffolding.DefaultUnlockRetryInterval,
+				os.Stdout,
+				gen.FetchPuzzle,
+			)
+
+			err = buildScaffolding(func() error {
+				return scaffolding.RetryWhileLocked(
+					scaffolding.SystemClock,
+					scaffolding.DefaultUnlockRetryWindow,
+					scaffolding.DefaultUnlockRetryInterval,
+					os.Stdout,
+					gen.Run,
+				)
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("🎅🏻 Merry coding!")
+
+			return nil
+		}
+
+		if len(targets) == 1 {
+			gen, err := scaffolding.NewGenerator(
+				targets[0].Day,
+				targets[0].Year,
+				viper.GetString("workdir"),
+				viper.GetString("templates"),
+				viper.GetString("profile"),
+				client,
+				store,
+				viper.GetBool("force"),
+			)
+			if err != nil {
+				return fmt.Errorf("making code generator: %w", err)
+			}
+
+			if err := buildScaffolding(gen.Run); err != nil {
+				return err
+			}
+
+			fmt.Println("🎅🏻 Merry coding!")
+
+			return nil
+		}
+
+		client.Interval = viper.GetDuration("delay")
+
+		results, err := scaffolding.RunBatch(
+			targets,
 			viper.GetString("workdir"),
-			viper.GetString("cookie"),
+			viper.GetString("templates"),
+			viper.GetString("profile"),
+			client,
+			store,
 			viper.GetBool("force"),
 		)
+
+		fmt.Println()
+		if err := scaffolding.WriteBatchSummary(os.Stdout, results); err != nil {
+			return err
+		}
 		if err != nil {
-			return fmt.Errorf("making code generator: %w", err)
+			return err
 		}
 
-		if err := gen.Run(); err != nil {
-			return fmt.Errorf("building scaffolding: %w", err)
+		for _, r := range results {
+			if r.Outcome == scaffolding.Failed {
+				return errors.New("some days could not be scaffolded")
+			}
 		}
 
 		fmt.Println("🎅🏻 Merry coding!")
@@ -55,21 +184,133 @@ your configuration file.`,
 	},
 }
 
+// buildScaffolding calls build, then updates the registry of solutions. The
+// registry is updated even if build fails, since the new package's code may
+// have been written already.
+func buildScaffolding(build func() error) error {
+	buildErr := build()
+
+	if err := scaffolding.UpdateRegistry(viper.GetString("workdir")); err != nil {
+		return fmt.Errorf("updating registry: %w", err)
+	}
+	if buildErr != nil {
+		return fmt.Errorf("building scaffolding: %w", buildErr)
+	}
+
+	return nil
+}
+
+// scaffoldTargets returns the days to scaffold, based on the command's flags.
+// With --all, only days unlocked at now are included. With --wait and no day,
+// the next puzzle to unlock after now is returned.
+func scaffoldTargets(now time.Time) ([]scaffolding.Target, error) {
+	wait := viper.GetBool("wait")
+	years := []int{viper.GetInt("year")}
+	if r := viper.GetString("year-range"); r != "" {
+		var err error
+		lastYear, _ := aoc.NextUnlock(now)
+		years, err = scaffolding.ParseRange(r, aoc.FirstYear, lastYear)
+		if err != nil {
+			return nil, fmt.Errorf("parsing --year-range: %w", err)
+		}
+	}
+
+	day, days, all := viper.GetInt("day"), viper.GetString("days"), viper.GetBool("all")
+
+	selected := 0
+	for _, set := range []bool{day != 0, days != "", all} {
+		if set {
+			selected++
+		}
+	}
+	if wait {
+		switch {
+		case days != "" || all || viper.GetString("year-range") != "":
+			return nil, errors.New("--wait only works with a single day")
+		case day == 0:
+			year, day := aoc.NextUnlock(now)
+			return []scaffolding.Target{{Year: year, Day: day}}, nil
+		case !viper.IsSet("year"):
+			// Wait for the upcoming Advent of Code, not the latest one.
+			year, _ := aoc.NextUnlock(now)
+			years = []int{year}
+		}
+	}
+
+	if selected != 1 {
+		return nil, errors.New("exactly one of --day, --days, and --all is required")
+	}
+
+	var targets []scaffolding.Target
+	for _, year := range years {
+		var numbers []int
+		switch {
+		case day != 0:
+			numbers = []int{day}
+		case days != "":
+			var err error
+			numbers, err = scaffolding.ParseRange(days, 1, aoc.DaysInYear(aoc.FirstYear))
+			if err != nil {
+				return nil, fmt.Errorf("parsing --days: %w", err)
+			}
+		case all:
+			for d := 1; d <= aoc.DaysInYear(year) && aoc.Unlocked(year, d, now); d++ {
+				numbers = append(numbers, d)
+			}
+		}
+
+		beyond := 0
+		for _, d := range numbers {
+			// Recent calendars are shorter, so a range of days may go beyond
+			// some of the years it is used with.
+			if d < 1 || d > aoc.DaysInYear(year) {
+				if days != "" {
+					beyond++
+					continue
+				}
+				return nil, fmt.Errorf("invalid day %d: Advent of Code %d has %d days", d, year, aoc.DaysInYear(year))
+			}
+			// Like --all, a range only includes unlocked days. A single day
+			// must be unlocked, unless waiting for it.
+			if !aoc.Unlocked(year, d, now) {
+				switch {
+				case days != "":
+					continue
+				case !wait:
+					return nil, fmt.Errorf("puzzle %d/%02d is not unlocked yet; use --wait to wait for it", year, d)
+				}
+			}
+			targets = append(targets, scaffolding.Target{Year: year, Day: d})
+		}
+		if beyond > 0 {
+			fmt.Printf("👉 Skipping %d days of %d; Advent of Code %d has %d days.\n", beyond, year, year, aoc.DaysInYear(year))
+		}
+	}
+
+	if len(targets) == 0 {
+		return nil, errors.New("no puzzle is unlocked yet")
+	}
+
+	return targets, nil
+}
+
 func init() {
 	rootCmd.AddCommand(scaffoldCmd)
 
 	scaffoldCmd.Flags().IntP("day", "d", 0, "The day to build scaffolding for")
+	scaffoldCmd.Flags().String("days", "", "The days to build scaffolding for, such as 1-25 or 1,3,5-7")
+	scaffoldCmd.Flags().Bool("all", false, "If true, build scaffolding for every unlocked day")
 
-	// Year defaults to latest Advent of Code.
-	year, month, _ := time.Now().Date()
-	if month < time.December {
-		year--
-	}
-	scaffoldCmd.Flags().IntP("year", "y", year, "The year of Advent of Code you are working on")
+	scaffoldCmd.Flags().IntP("year", "y", latestYear(), "The year of Advent of Code you are working on")
+	scaffoldCmd.Flags().String("year-range", "", "The years to build scaffolding for, such as 2015-2020; overrides --year")
 
 	scaffoldCmd.Flags().StringP("workdir", "w", "", "Your Advent of Code working directory")
+	scaffoldCmd.Flags().StringP("templates", "t", "", "Directory with templates overriding the default ones")
 	scaffoldCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
 	scaffoldCmd.Flags().BoolP("force", "f", false, "If true, overwrite existing files")
+	scaffoldCmd.Flags().Bool("wait", false, "If true, wait for the puzzle to unlock before building scaffolding")
+	scaffoldCmd.Flags().Duration("delay", 3*time.Second, "Minimum delay between requests to adventofcode.com when scaffolding several days")
 
-	viper.BindPFlags(scaffoldCmd.Flags())
+	_ = scaffoldCmd.RegisterFlagCompletionFunc("year", completeScaffoldYears)
+	_ = scaffoldCmd.RegisterFlagCompletionFunc("day", completeScaffoldDays)
 }
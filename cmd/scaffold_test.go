@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/scaffolding"
+	"github.com/spf13/viper"
+)
+
+// setFlags sets the given settings for the duration of the test.
+func setFlags(t *testing.T, settings map[string]any) {
+	for key, value := range settings {
+		viper.Set(key, value)
+	}
+	t.Cleanup(func() {
+		for key := range settings {
+			viper.Set(key, nil)
+		}
+	})
+}
+
+func TestScaffoldTargetsShortCalendar(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	setFlags(t, map[string]any{"year-range": "2024-2025", "days": "1-25"})
+	targets, err := scaffoldTargets(now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := len(targets), 25+12; got != want {
+		t.Errorf("got %d targets, want %d", got, want)
+	}
+	if got, want := targets[len(targets)-1], (scaffolding.Target{Year: 2025, Day: 12}); got != want {
+		t.Errorf("last target is %v, want %v", got, want)
+	}
+
+	// A single day must exist.
+	setFlags(t, map[string]any{"year-range": "", "days": "", "year": 2025, "day": 20})
+	if _, err := scaffoldTargets(now); err == nil {
+		t.Errorf("scaffolding day 20 of 2025 did not fail")
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/busser/adventofcode/benchmark"
+	"github.com/busser/adventofcode/metrics"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// statsCmd represents the stats command
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Rank solutions by code metrics",
+	Long: `Rank solutions by code metrics, to find which puzzles took the most code
+and which helpers are reused the most.
+
+For each package, the command measures lines of code, declared types and
+functions, the cyclomatic complexity of PartOne and PartTwo, and which
+identifiers of the helpers package it uses. Tests are not measured. Timings
+come from the benchmark history, if solutions were benchmarked with
+'adventofcode bench'.
+
+Packages can be ranked by lines, types, funcs, complexity, helpers, or time.
+Metrics are also summed by year, and helpers ranked by how many packages use
+them.
+
+Examples:
+  # Show the 20 largest solutions.
+  adventofcode stats
+
+  # Show the 10 most complex solutions of 2024.
+  adventofcode stats --year=2024 --sort=complexity --top=10
+
+  # Show metrics of all solutions, as JSON.
+  adventofcode stats --top=0 --format=json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workdir := viper.GetString("workdir")
+
+		packages, err := metrics.Measure(workdir)
+		if err != nil {
+			return fmt.Errorf("measuring solutions: %w", err)
+		}
+
+		if year := viper.GetInt("year"); year != 0 {
+			var selected []metrics.Package
+			for _, p := range packages {
+				if p.Year == year {
+					selected = append(selected, p)
+				}
+			}
+			packages = selected
+		}
+		if len(packages) == 0 {
+			return fmt.Errorf("no matching solutions")
+		}
+
+		history, err := benchmark.ReadHistory(historyFile(workdir))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("reading benchmark history: %w", err)
+		}
+		metrics.AddBenchmarks(packages, history)
+
+		report, err := metrics.NewReport(packages, viper.GetString("sort"), viper.GetInt("top"))
+		if err != nil {
+			return err
+		}
+
+		return metrics.WriteReport(os.Stdout, report, viper.GetString("format"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().IntP("year", "y", 0, "Only measure solutions for this year")
+	statsCmd.Flags().StringP("sort", "s", metrics.ByLines, "Metric to rank solutions by: lines, types, funcs, complexity, helpers, or time")
+	statsCmd.Flags().IntP("top", "n", 20, "Number of solutions to show, or 0 for all")
+	statsCmd.Flags().StringP("format", "o", metrics.FormatText, "Output format: text or json")
+	statsCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+	statsCmd.Flags().String("history", "", "Benchmark history file (default is .adventofcode/bench-history.jsonl in the working directory)")
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/workspace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show which puzzles are solved",
+	Long: `Show which puzzles are solved, as an Advent calendar.
+
+A part of a puzzle counts as solved once its solution is implemented and the
+expected output of its example in solution_test.go is known. The second part of
+the last puzzle of each year is solved once all other parts are.
+
+Examples:
+  # Show the status of all years.
+  adventofcode status
+
+  # Show the status of a single year, as JSON.
+  adventofcode status --year=2024 --format=json`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workdir := viper.GetString("workdir")
+
+		var years []workspace.Year
+		if year := viper.GetInt("year"); year != 0 {
+			y, err := workspace.ScanYear(workdir, year)
+			if err != nil {
+				return fmt.Errorf("scanning working directory: %w", err)
+			}
+			years = append(years, y)
+		} else {
+			var err error
+			years, err = workspace.Scan(workdir)
+			if err != nil {
+				return fmt.Errorf("scanning working directory: %w", err)
+			}
+		}
+
+		return workspace.WriteStatus(os.Stdout, years, viper.GetString("format"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().IntP("year", "y", 0, "Only show the status of this year")
+	statusCmd.Flags().StringP("format", "o", workspace.FormatText, "Output format: text or json")
+	statusCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/ledger"
+	"github.com/busser/adventofcode/registry"
+	"github.com/busser/adventofcode/scaffolding"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// submitCmd represents the submit command
+var submitCmd = &cobra.Command{
+	Use:   "submit",
+	Short: "Run a solution and submit its answer",
+	Long: `Run a solution against your input and submit its answer to adventofcode.com.
+
+Every attempt is recorded in a ledger, so that answers known to be wrong are
+never submitted twice. Numeric answers are also checked against previous
+"too high" and "too low" verdicts before being submitted.
+
+Once an answer is accepted, it is written as the expected output of the
+matching example in the package's solution_test.go file. Existing answers are
+only overwritten with the '--force' flag.
+
+With the '--profile' flag, the input of the profile is used instead, from the
+package's testdata/<profile>/input.txt file, and accepted answers are written
+to the profile's entry of the answers variable in solution_test.go. Each
+profile has its own ledger.
+
+Examples:
+  # Submit the answer to part 1 of day 17.
+  adventofcode submit --year=2024 --day=17 --part=1
+
+  # Submit the answer computed from a specific input file.
+  adventofcode submit --year=2024 --day=17 --part=2 --input=input.txt
+
+  # Submit the answer for the input of the alice profile.
+  adventofcode submit --year=2024 --day=17 --part=1 --profile=alice
+
+The CLI assumes you are working on the latest Advent of Code. You can always
+override this behavior with the '--year' flag.
+
+To submit answers, provide the value of the 'session' cookie for the
+adventofcode.com website. You can do this with the '--cookie' flag, the
+ADVENTOFCODE_COOKIE environment variable, or by setting the 'cookie' field in
+your configuration file.`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key := registry.Key{
+			Year: viper.GetInt("year"),
+			Day:  viper.GetInt("day"),
+			Part: viper.GetInt("part"),
+		}
+		workdir := viper.GetString("workdir")
+		profile := viper.GetString("profile")
+
+		solution, ok := registry.Lookup(key.Year, key.Day, key.Part)
+		if !ok {
+			return fmt.Errorf("no solution for %s", key)
+		}
+
+		inputPath := viper.GetString("input")
+		if inputPath == "" {
+			inputPath = filepath.Join(workdir, key.ProfileInputFile(profile))
+		}
+
+		input, err := openInput(inputPath)
+		if err != nil {
+			return err
+		}
+		defer input.Close()
+
+		var output bytes.Buffer
+		if err := solution.Solve(input, &output); err != nil {
+			return fmt.Errorf("solving: %w", err)
+		}
+		answer := strings.TrimSpace(output.String())
+		if answer == "" {
+			return errors.New("solution gave an empty answer")
+		}
+
+		fmt.Printf("🧮 Answer to %s: %s\n", key, answer)
+
+		ledgerPath := viper.GetString("ledger")
+		if ledgerPath == "" {
+			ledgerPath = filepath.Join(workdir, ledger.ProfileFile(profile))
+		}
+
+		book, err := ledger.Load(ledgerPath)
+		if err != nil {
+			return err
+		}
+
+		if err := book.Check(key.Year, key.Day, key.Part, answer); err != nil {
+			return fmt.Errorf("not submitting: %w", err)
+		}
+
+		if sessionCookie() == "" {
+			return errors.New("no session cookie provided")
+		}
+
+		result, err := newClient().SubmitAnswer(key.Year, key.Day, key.Part, answer)
+		if err != nil {
+			return fmt.Errorf("submitting answer: %w", err)
+		}
+
+		book.Add(ledger.Attempt{
+			Year:    key.Year,
+			Day:     key.Day,
+			Part:    key.Part,
+			Answer:  answer,
+			Verdict: result.Verdict,
+			Time:    time.Now().UTC(),
+		})
+		if err := book.Save(); err != nil {
+			return err
+		}
+
+		if err := reportVerdict(result); err != nil {
+			return err
+		}
+
+		testFile := filepath.Join(workdir, key.PackageDir(), "solution_test.go")
+
+		// Tests check the answer for the package's input, which may not be
+		// the one solved.
+		if viper.GetString("input") != "" {
+			fmt.Printf("  👉 Not updating %s; the answer is for another input than the package's.\n", testFile)
+			return nil
+		}
+
+		if profile != "" {
+			if err := scaffolding.WriteProfileAnswer(testFile, profile, key.Part, answer, viper.GetBool("force")); err != nil {
+				return fmt.Errorf("updating answers: %w", err)
+			}
+			fmt.Printf("  👉 Updated expected answer of %s in %s.\n", profile, testFile)
+			return nil
+		}
+
+		if err := scaffolding.WriteExampleAnswer(testFile, key.Part, answer, viper.GetBool("force")); err != nil {
+			return fmt.Errorf("updating example: %w", err)
+		}
+		fmt.Printf("  👉 Updated expected output in %s.\n", testFile)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(submitCmd)
+
+	submitCmd.Flags().IntP("day", "d", 0, "The day of the puzzle to solve")
+	submitCmd.Flags().IntP("year", "y", latestYear(), "The year of the puzzle to solve")
+	submitCmd.Flags().IntP("part", "p", 1, "The part of the puzzle to solve")
+	submitCmd.Flags().StringP("input", "i", "", "File to read the input from, or - for standard input (default is the package's testdata/input.txt, or testdata/<profile>/input.txt)")
+	submitCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+	submitCmd.Flags().StringP("cookie", "c", "", "Your session cookie for adventofcode.com")
+	submitCmd.Flags().String("ledger", "", "File to record attempts in (default is .adventofcode/answers.json in the working directory, or .adventofcode/profiles/<profile>/answers.json)")
+	submitCmd.Flags().BoolP("force", "f", false, "If true, overwrite the existing answer of the example")
+
+	_ = submitCmd.RegisterFlagCompletionFunc("year", completeRegisteredYears)
+	_ = submitCmd.RegisterFlagCompletionFunc("day", completeRegisteredDays)
+}
+
+// reportVerdict prints the verdict of a submission. It returns an error if the
+// answer was not accepted.
+func reportVerdict(result aoc.SubmitResult) error {
+	switch result.Verdict {
+	case aoc.Correct:
+		fmt.Println("⭐ That's the right answer!")
+		return nil
+	case aoc.TooHigh:
+		fmt.Println("❌ That's not the right answer; your answer is too high.")
+	case aoc.TooLow:
+		fmt.Println("❌ That's not the right answer; your answer is too low.")
+	case aoc.Incorrect:
+		fmt.Println("❌ That's not the right answer.")
+	case aoc.RateLimited:
+		fmt.Println("⏳ You gave an answer too recently.")
+	case aoc.WrongLevel:
+		fmt.Println("🤔 You don't seem to be solving the right level. Did you already complete it?")
+	}
+
+	if result.Wait > 0 {
+		fmt.Printf("  👉 Wait %s before trying again.\n", result.Wait)
+	}
+
+	return fmt.Errorf("answer not accepted: %s", result.Verdict)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/busser/adventofcode/watch"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Rerun a solution whenever it changes",
+	Long: `Rerun a solution whenever its code or input changes.
+
+The solution is rebuilt and both of its parts run against your input every
+time a Go file or a testdata file of its package is saved. Each answer is shown
+with the time it took, and compared to the expected output of its example in
+solution_test.go, if known. Saves happening in quick succession trigger a
+single run, and a run still in progress is stopped when a file changes.
+
+Examples:
+  # Watch the solution of day 17.
+  adventofcode watch --day=17
+
+  # Watch the solution of day 17, running against the input of a profile.
+  adventofcode watch --day=17 --profile=alice
+
+The CLI assumes you are working on the latest Advent of Code. You can always
+override this behavior with the '--year' flag.`,
+	Args: cobra.NoArgs,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return viper.BindPFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		runner, err := watch.NewRunner(
+			viper.GetString("workdir"),
+			viper.GetInt("year"),
+			viper.GetInt("day"),
+			viper.GetString("profile"),
+		)
+		if err != nil {
+			return fmt.Errorf("preparing runner: %w", err)
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		return watch.Watch(ctx, runner, viper.GetDuration("debounce"), os.Stdout)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().IntP("day", "d", 0, "The day of the puzzle to watch")
+	watchCmd.Flags().IntP("year", "y", latestYear(), "The year of the puzzle to watch")
+	watchCmd.Flags().StringP("workdir", "w", ".", "Your Advent of Code working directory")
+	watchCmd.Flags().Duration("debounce", watch.DefaultDelay, "How long to wait for changes to settle before running again")
+
+	_ = watchCmd.RegisterFlagCompletionFunc("year", completeRegisteredYears)
+	_ = watchCmd.RegisterFlagCompletionFunc("day", completeRegisteredDays)
+}
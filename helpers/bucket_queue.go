@@ -0,0 +1,91 @@
+package helpers
+
+// A BucketQueue is a priority queue for small non-negative integer priorities,
+// such as distances in a grid. It keeps one bucket of items per priority, so
+// pushing is constant time and popping only scans empty buckets. Items with the
+// same priority come out last in, first out.
+//
+// It is fastest when priorities popped never decrease, like in Dijkstra's
+// algorithm, and when the difference between the lowest and highest priority
+// queued stays small.
+type BucketQueue[T any] struct {
+	buckets [][]T
+	first   int // lowest priority that may hold items
+	len     int
+}
+
+func NewBucketQueue[T any]() *BucketQueue[T] {
+	return &BucketQueue[T]{}
+}
+
+func (bq BucketQueue[T]) Len() int {
+	return bq.len
+}
+
+// Push adds x to the queue with the given priority. Lower priorities come out
+// first. It panics if priority is negative.
+func (bq *BucketQueue[T]) Push(x T, priority int) {
+	if priority < 0 {
+		panic("helpers: negative priority pushed to a BucketQueue")
+	}
+	for priority >= len(bq.buckets) {
+		bq.buckets = append(bq.buckets, nil)
+	}
+	bq.buckets[priority] = append(bq.buckets[priority], x)
+	if bq.len == 0 || priority < bq.first {
+		bq.first = priority
+	}
+	bq.len++
+}
+
+// Pop removes and returns an item with the lowest priority, along with its
+// priority.
+func (bq *BucketQueue[T]) Pop() (T, int) {
+	if bq.len == 0 {
+		panic("helpers: Pop called on an empty BucketQueue")
+	}
+	for len(bq.buckets[bq.first]) == 0 {
+		bq.first++
+	}
+
+	bucket := bq.buckets[bq.first]
+	popped := bucket[len(bucket)-1]
+	bucket[len(bucket)-1] = *new(T)
+	bq.buckets[bq.first] = bucket[:len(bucket)-1]
+	bq.len--
+
+	return popped, bq.first
+}
+
+// TryPop is like Pop, but returns false instead of panicking if the queue is
+// empty.
+func (bq *BucketQueue[T]) TryPop() (T, int, bool) {
+	if bq.len == 0 {
+		return *new(T), 0, false
+	}
+	x, priority := bq.Pop()
+	return x, priority, true
+}
+
+// Peek returns an item with the lowest priority and its priority, without
+// removing it, or returns false if the queue is empty.
+func (bq *BucketQueue[T]) Peek() (T, int, bool) {
+	if bq.len == 0 {
+		return *new(T), 0, false
+	}
+	for len(bq.buckets[bq.first]) == 0 {
+		bq.first++
+	}
+	bucket := bq.buckets[bq.first]
+	return bucket[len(bucket)-1], bq.first, true
+}
+
+// Clear removes all items from the queue, keeping its buckets' capacity.
+func (bq *BucketQueue[T]) Clear() {
+	for i, bucket := range bq.buckets {
+		clear(bucket)
+		bq.buckets[i] = bucket[:0]
+	}
+	bq.first = 0
+	bq.len = 0
+}
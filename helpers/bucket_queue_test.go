@@ -0,0 +1,50 @@
+package helpers
+
+import "testing"
+
+func TestBucketQueue(t *testing.T) {
+	bq := NewBucketQueue[string]()
+
+	if _, _, ok := bq.TryPop(); ok {
+		t.Errorf("TryPop on an empty queue returned an item")
+	}
+
+	bq.Push("c", 7)
+	bq.Push("a", 2)
+	bq.Push("b", 5)
+	bq.Push("b2", 5)
+
+	if x, p, ok := bq.Peek(); !ok || x != "a" || p != 2 {
+		t.Errorf("Peek: got %q, %d, %t", x, p, ok)
+	}
+	if x, p := bq.Pop(); x != "a" || p != 2 {
+		t.Errorf("Pop: got %q, %d", x, p)
+	}
+
+	// Lower priorities pushed later still come out first.
+	bq.Push("z", 0)
+
+	expected := []struct {
+		item     string
+		priority int
+	}{
+		{"z", 0},
+		{"b2", 5},
+		{"b", 5},
+		{"c", 7},
+	}
+	for _, e := range expected {
+		if x, p, ok := bq.TryPop(); !ok || x != e.item || p != e.priority {
+			t.Fatalf("expected %q with priority %d, got %q with priority %d", e.item, e.priority, x, p)
+		}
+	}
+	if bq.Len() != 0 {
+		t.Errorf("got %d items, want none", bq.Len())
+	}
+
+	bq.Push("x", 3)
+	bq.Clear()
+	if _, _, ok := bq.Peek(); ok || bq.Len() != 0 {
+		t.Errorf("queue not empty after Clear")
+	}
+}
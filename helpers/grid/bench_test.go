@@ -0,0 +1,219 @@
+package grid
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/busser/adventofcode/helpers"
+)
+
+// The benchmarks below compare Grid with the [][]byte grids that solutions
+// used before this package existed, on the inputs of those solutions.
+var benchmarkInputs = []struct {
+	name     string
+	dataFile string
+}{
+	{"y2020-d11", "../../y2020/d11/testdata/input.txt"},
+	{"y2022-d12", "../../y2022/d12/testdata/input.txt"},
+	{"y2024-d15", "../../y2024/d15/testdata/input.txt"},
+	{"y2024-d16", "../../y2024/d16/testdata/input.txt"},
+	{"y2024-d20", "../../y2024/d20/testdata/input.txt"},
+	{"y2025-d07", "../../y2025/d07/testdata/input.txt"},
+}
+
+// readGridInput returns the grid found at the start of a puzzle input, up to
+// the first empty line.
+func readGridInput(b *testing.B, dataFile string) []byte {
+	b.Helper()
+
+	data, err := os.ReadFile(dataFile)
+	if err != nil {
+		b.Fatalf("could not read test data file: %v", err)
+	}
+	if i := bytes.Index(data, []byte("\n\n")); i >= 0 {
+		data = data[:i+1]
+	}
+
+	return data
+}
+
+func adHocFromReader(b *testing.B, data []byte) [][]byte {
+	lines, err := helpers.LinesFromReader(bytes.NewReader(data))
+	if err != nil {
+		b.Fatalf("parsing grid: %v", err)
+	}
+
+	tiles := make([][]byte, len(lines))
+	for row, line := range lines {
+		tiles[row] = []byte(line)
+	}
+
+	return tiles
+}
+
+func gridFromReader(b *testing.B, data []byte) *Grid[byte] {
+	g, err := FromReader(bytes.NewReader(data))
+	if err != nil {
+		b.Fatalf("parsing grid: %v", err)
+	}
+	return g
+}
+
+func BenchmarkFromReader(b *testing.B) {
+	for _, input := range benchmarkInputs {
+		data := readGridInput(b, input.dataFile)
+
+		b.Run(input.name+"/ad-hoc", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_ = adHocFromReader(b, data)
+			}
+		})
+
+		b.Run(input.name+"/grid", func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_ = gridFromReader(b, data)
+			}
+		})
+	}
+}
+
+// BenchmarkNeighbors8 counts, for every cell, the neighbors holding the same
+// byte.
+func BenchmarkNeighbors8(b *testing.B) {
+	for _, input := range benchmarkInputs {
+		data := readGridInput(b, input.dataFile)
+
+		b.Run(input.name+"/ad-hoc", func(b *testing.B) {
+			tiles := adHocFromReader(b, data)
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				count := 0
+				for i := range tiles {
+					for j := range tiles[i] {
+						for ii := i - 1; ii <= i+1; ii++ {
+							if ii < 0 || ii >= len(tiles) {
+								continue
+							}
+							for jj := j - 1; jj <= j+1; jj++ {
+								if i == ii && j == jj {
+									continue
+								}
+								if jj < 0 || jj >= len(tiles[ii]) {
+									continue
+								}
+								if tiles[ii][jj] == tiles[i][j] {
+									count++
+								}
+							}
+						}
+					}
+				}
+				_ = count
+			}
+		})
+
+		b.Run(input.name+"/grid", func(b *testing.B) {
+			g := gridFromReader(b, data)
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				count := 0
+				for p, v := range g.All() {
+					for q := range g.Neighbors8(p) {
+						if g.At(q) == v {
+							count++
+						}
+					}
+				}
+				_ = count
+			}
+		})
+	}
+}
+
+// BenchmarkBreadthFirstSearch visits every cell that is not a wall and can be
+// reached from the first such cell.
+func BenchmarkBreadthFirstSearch(b *testing.B) {
+	const wall = '#'
+
+	type position struct {
+		row, col int
+	}
+
+	for _, input := range benchmarkInputs {
+		data := readGridInput(b, input.dataFile)
+
+		b.Run(input.name+"/ad-hoc", func(b *testing.B) {
+			tiles := adHocFromReader(b, data)
+			isWithinBounds := func(pos position) bool {
+				return pos.row >= 0 && pos.row < len(tiles) &&
+					pos.col >= 0 && pos.col < len(tiles[pos.row])
+			}
+			var start position
+		findStart:
+			for row := range tiles {
+				for col := range tiles[row] {
+					if tiles[row][col] != wall {
+						start = position{row, col}
+						break findStart
+					}
+				}
+			}
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				visited := make([][]bool, len(tiles))
+				for row := range visited {
+					visited[row] = make([]bool, len(tiles[row]))
+				}
+				visited[start.row][start.col] = true
+				queue := []position{start}
+
+				for len(queue) > 0 {
+					pos := queue[0]
+					queue = queue[1:]
+
+					for _, next := range []position{
+						{pos.row - 1, pos.col},
+						{pos.row, pos.col + 1},
+						{pos.row + 1, pos.col},
+						{pos.row, pos.col - 1},
+					} {
+						if !isWithinBounds(next) || visited[next.row][next.col] || tiles[next.row][next.col] == wall {
+							continue
+						}
+						visited[next.row][next.col] = true
+						queue = append(queue, next)
+					}
+				}
+			}
+		})
+
+		b.Run(input.name+"/grid", func(b *testing.B) {
+			g := gridFromReader(b, data)
+			start := g.FindFunc(func(v byte) bool { return v != wall })[0]
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				visited := make([]bool, len(g.Cells()))
+				visited[g.Index(start)] = true
+				queue := []Point{start}
+
+				for len(queue) > 0 {
+					p := queue[0]
+					queue = queue[1:]
+
+					for q := range g.Neighbors4(p) {
+						if visited[g.Index(q)] || g.At(q) == wall {
+							continue
+						}
+						visited[g.Index(q)] = true
+						queue = append(queue, q)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Package grid provides a two-dimensional grid of cells, as found in many
+// Advent of Code puzzles.
+package grid
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+)
+
+// A Grid is a rectangle of cells holding values of type T. Cells are stored
+// row after row in a single slice.
+type Grid[T any] struct {
+	width, height int
+	cells         []T
+}
+
+// New returns a grid of the given size, with all cells set to the zero value.
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{
+		width:  width,
+		height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// FromReader returns a grid with one cell per byte of r, and one row per line.
+// All lines must have the same length. Line endings and a trailing empty line
+// are ignored.
+func FromReader(r io.Reader) (*Grid[byte], error) {
+	g := &Grid[byte]{}
+
+	err := scanRows(r, g, func(line []byte) error {
+		g.cells = append(g.cells, line...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// FromReaderFunc returns a grid with one cell per byte of r, and one row per
+// line. The value of each cell is parse's result for its byte. All lines must
+// have the same length. Line endings and a trailing empty line are ignored.
+func FromReaderFunc[T any](r io.Reader, parse func(b byte) (T, error)) (*Grid[T], error) {
+	g := &Grid[T]{}
+
+	err := scanRows(r, g, func(line []byte) error {
+		for col, b := range line {
+			v, err := parse(b)
+			if err != nil {
+				return fmt.Errorf("line %d, column %d: %w", g.height+1, col+1, err)
+			}
+			g.cells = append(g.cells, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// scanRows calls addRow for each line of r, and sets the size of g
+// accordingly. The cells of g are left to addRow.
+func scanRows[T any](r io.Reader, g *Grid[T], addRow func(line []byte) error) error {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		line := bytes.TrimSuffix(s.Bytes(), []byte("\r"))
+
+		if g.height == 0 {
+			g.width = len(line)
+		}
+		if len(line) != g.width {
+			return fmt.Errorf("line %d has length %d, want %d", g.height+1, len(line), g.width)
+		}
+
+		if err := addRow(line); err != nil {
+			return err
+		}
+		g.height++
+	}
+	if err := s.Err(); err != nil {
+		return fmt.Errorf("failed to scan reader: %w", err)
+	}
+
+	return nil
+}
+
+// Width returns the number of columns of g.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows of g.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// InBounds reports whether p is the position of a cell of g.
+func (g *Grid[T]) InBounds(p Point) bool {
+	return uint(p.Row) < uint(g.height) && uint(p.Col) < uint(g.width)
+}
+
+// At returns the value of the cell at p, which must be in bounds.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[p.Row*g.width+p.Col]
+}
+
+// Set sets the value of the cell at p, which must be in bounds.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[p.Row*g.width+p.Col] = v
+}
+
+// Index returns the index of the cell at p in Cells.
+func (g *Grid[T]) Index(p Point) int {
+	return p.Row*g.width + p.Col
+}
+
+// PointOf returns the position of the cell at index i in Cells.
+func (g *Grid[T]) PointOf(i int) Point {
+	return Point{i / g.width, i % g.width}
+}
+
+// Cells returns the cells of g, row after row. Changes to the slice change g.
+// This is useful to index other slices the same way as g, with Index and
+// PointOf.
+func (g *Grid[T]) Cells() []T {
+	return g.cells
+}
+
+// All returns an iterator over the positions and values of all cells of g, row
+// after row.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		i := 0
+		for row := 0; row < g.height; row++ {
+			for col := 0; col < g.width; col++ {
+				if !yield(Point{row, col}, g.cells[i]) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Neighbors4 returns an iterator over the positions of the orthogonal
+// neighbors of p that are in bounds.
+func (g *Grid[T]) Neighbors4(p Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range &Directions4 {
+			if q := p.Add(d); g.InBounds(q) && !yield(q) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors8 returns an iterator over the positions of the neighbors of p,
+// including diagonals, that are in bounds.
+func (g *Grid[T]) Neighbors8(p Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range &Directions8 {
+			if q := p.Add(d); g.InBounds(q) && !yield(q) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a copy of g.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{
+		width:  g.width,
+		height: g.height,
+		cells:  append([]T(nil), g.cells...),
+	}
+}
+
+// Transpose returns a copy of g with rows and columns swapped.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	t := New[T](g.height, g.width)
+	for row := 0; row < g.height; row++ {
+		for col := 0; col < g.width; col++ {
+			t.cells[col*t.width+row] = g.cells[row*g.width+col]
+		}
+	}
+	return t
+}
+
+// RotateClockwise returns a copy of g rotated by 90 degrees clockwise.
+func (g *Grid[T]) RotateClockwise() *Grid[T] {
+	t := New[T](g.height, g.width)
+	for row := 0; row < g.height; row++ {
+		for col := 0; col < g.width; col++ {
+			t.cells[col*t.width+(g.height-1-row)] = g.cells[row*g.width+col]
+		}
+	}
+	return t
+}
+
+// RotateCounterClockwise returns a copy of g rotated by 90 degrees
+// counter-clockwise.
+func (g *Grid[T]) RotateCounterClockwise() *Grid[T] {
+	t := New[T](g.height, g.width)
+	for row := 0; row < g.height; row++ {
+		for col := 0; col < g.width; col++ {
+			t.cells[(g.width-1-col)*t.width+row] = g.cells[row*g.width+col]
+		}
+	}
+	return t
+}
+
+// FlipHorizontal returns a copy of g mirrored left to right.
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	t := New[T](g.width, g.height)
+	for row := 0; row < g.height; row++ {
+		for col := 0; col < g.width; col++ {
+			t.cells[row*g.width+(g.width-1-col)] = g.cells[row*g.width+col]
+		}
+	}
+	return t
+}
+
+// FlipVertical returns a copy of g mirrored top to bottom.
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	t := New[T](g.width, g.height)
+	for row := 0; row < g.height; row++ {
+		copy(t.cells[(g.height-1-row)*g.width:(g.height-row)*g.width], g.cells[row*g.width:(row+1)*g.width])
+	}
+	return t
+}
+
+// FindFunc returns the positions of all cells of g for which match returns
+// true, row after row.
+func (g *Grid[T]) FindFunc(match func(T) bool) []Point {
+	var found []Point
+	for i, v := range g.cells {
+		if match(v) {
+			found = append(found, Point{i / g.width, i % g.width})
+		}
+	}
+	return found
+}
+
+// FindAll returns the positions of all cells of g holding v, row after row.
+func FindAll[T comparable](g *Grid[T], v T) []Point {
+	var found []Point
+	for i, c := range g.cells {
+		if c == v {
+			found = append(found, Point{i / g.width, i % g.width})
+		}
+	}
+	return found
+}
+
+// Find returns the position of the first cell of g holding v, row after row.
+// It returns false if there is none.
+func Find[T comparable](g *Grid[T], v T) (Point, bool) {
+	for i, c := range g.cells {
+		if c == v {
+			return Point{i / g.width, i % g.width}, true
+		}
+	}
+	return Point{}, false
+}
+
+// String renders g with one line per row. Cells holding bytes or runes are
+// rendered as characters, and booleans as '#' for true and '.' for false.
+// Other values are formatted with fmt and separated by spaces.
+func (g *Grid[T]) String() string {
+	var b strings.Builder
+
+	for row := 0; row < g.height; row++ {
+		for col, v := range g.cells[row*g.width : (row+1)*g.width] {
+			switch v := any(v).(type) {
+			case byte:
+				b.WriteByte(v)
+			case rune:
+				b.WriteRune(v)
+			case bool:
+				if v {
+					b.WriteByte('#')
+				} else {
+					b.WriteByte('.')
+				}
+			default:
+				if col > 0 {
+					b.WriteByte(' ')
+				}
+				fmt.Fprint(&b, v)
+			}
+		}
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
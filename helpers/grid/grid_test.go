@@ -0,0 +1,221 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testGrid = "abc\r\ndef\n"
+
+func mustParse(t testing.TB, s string) *Grid[byte] {
+	t.Helper()
+
+	g, err := FromReader(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("parsing grid: %v", err)
+	}
+	return g
+}
+
+func ExampleFromReader() {
+	g, err := FromReader(strings.NewReader("#..\n.S.\n..#\n"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	start, _ := Find(g, 'S')
+	walls := 0
+	for p := range g.Neighbors8(start) {
+		if g.At(p) == '#' {
+			walls++
+		}
+	}
+
+	fmt.Printf("%dx%d grid, start at %v, next to %d walls\n", g.Width(), g.Height(), start, walls)
+	// Output: 3x3 grid, start at {1 1}, next to 2 walls
+}
+
+func TestFromReader(t *testing.T) {
+	g := mustParse(t, testGrid)
+
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("got %dx%d grid, want 3x2", g.Width(), g.Height())
+	}
+	if diff := cmp.Diff([]byte("abcdef"), g.Cells()); diff != "" {
+		t.Errorf("cells mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := FromReader(strings.NewReader("abc\nde\n")); err == nil {
+		t.Errorf("expected an error for lines of different lengths")
+	}
+
+	empty := mustParse(t, "")
+	if empty.Width() != 0 || empty.Height() != 0 {
+		t.Errorf("got %dx%d grid for empty input", empty.Width(), empty.Height())
+	}
+}
+
+func TestFromReaderFunc(t *testing.T) {
+	digit := func(b byte) (int, error) {
+		if b < '0' || b > '9' {
+			return 0, errors.New("not a digit")
+		}
+		return int(b - '0'), nil
+	}
+
+	g, err := FromReaderFunc(strings.NewReader("12\n34\n"), digit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := g.At(Point{1, 0}); got != 3 {
+		t.Errorf("got %d at (1, 0), want 3", got)
+	}
+	if got, want := g.String(), "1 2\n3 4\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	_, err = FromReaderFunc(strings.NewReader("12\n3x\n"), digit)
+	if err == nil || err.Error() != "line 2, column 2: not a digit" {
+		t.Errorf("got error %v", err)
+	}
+}
+
+func TestAccess(t *testing.T) {
+	g := New[int](4, 3)
+
+	for _, p := range []Point{{0, 0}, {2, 3}, {1, 2}} {
+		if !g.InBounds(p) {
+			t.Errorf("%v should be in bounds", p)
+		}
+	}
+	for _, p := range []Point{{-1, 0}, {0, -1}, {3, 0}, {0, 4}} {
+		if g.InBounds(p) {
+			t.Errorf("%v should be out of bounds", p)
+		}
+	}
+
+	g.Set(Point{2, 1}, 7)
+	if got := g.At(Point{2, 1}); got != 7 {
+		t.Errorf("got %d, want 7", got)
+	}
+	if i := g.Index(Point{2, 1}); i != 9 || g.Cells()[i] != 7 || g.PointOf(i) != (Point{2, 1}) {
+		t.Errorf("index %d does not match point (2, 1)", i)
+	}
+
+	var visited []Point
+	for p, v := range g.All() {
+		if v == 7 {
+			visited = append(visited, p)
+		}
+	}
+	if diff := cmp.Diff([]Point{{2, 1}}, visited); diff != "" {
+		t.Errorf("All mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[byte](3, 3)
+
+	collect := func(seq func(func(Point) bool)) []Point {
+		var points []Point
+		for p := range seq {
+			points = append(points, p)
+		}
+		return points
+	}
+
+	testCases := []struct {
+		name string
+		got  []Point
+		want []Point
+	}{
+		{"4_center", collect(g.Neighbors4(Point{1, 1})), []Point{{0, 1}, {1, 2}, {2, 1}, {1, 0}}},
+		{"4_corner", collect(g.Neighbors4(Point{0, 0})), []Point{{0, 1}, {1, 0}}},
+		{"8_center", collect(g.Neighbors8(Point{1, 1})), []Point{{0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 1}, {2, 0}, {1, 0}, {0, 0}}},
+		{"8_edge", collect(g.Neighbors8(Point{2, 1})), []Point{{1, 1}, {1, 2}, {2, 2}, {2, 0}, {1, 0}}},
+	}
+	for _, tc := range testCases {
+		if diff := cmp.Diff(tc.want, tc.got); diff != "" {
+			t.Errorf("%s: neighbors mismatch (-want +got):\n%s", tc.name, diff)
+		}
+	}
+
+	// Iteration stops early.
+	n := 0
+	for range g.Neighbors8(Point{1, 1}) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("iterated over %d neighbors, want 2", n)
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	g := mustParse(t, testGrid)
+
+	testCases := map[string]struct {
+		got  *Grid[byte]
+		want string
+	}{
+		"clone":                    {g.Clone(), "abc\ndef\n"},
+		"transpose":                {g.Transpose(), "ad\nbe\ncf\n"},
+		"rotate_clockwise":         {g.RotateClockwise(), "da\neb\nfc\n"},
+		"rotate_counter_clockwise": {g.RotateCounterClockwise(), "cf\nbe\nad\n"},
+		"flip_horizontal":          {g.FlipHorizontal(), "cba\nfed\n"},
+		"flip_vertical":            {g.FlipVertical(), "def\nabc\n"},
+		"full_turn":                {g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef\n"},
+	}
+	for name, tc := range testCases {
+		if got := tc.got.String(); got != tc.want {
+			t.Errorf("%s: got %q, want %q", name, got, tc.want)
+		}
+	}
+
+	// Transformations return copies.
+	clone := g.Clone()
+	clone.Set(Point{0, 0}, 'z')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Errorf("changing a clone changed the original grid")
+	}
+}
+
+func TestFind(t *testing.T) {
+	g := mustParse(t, "#.#\n.#.\n")
+
+	if diff := cmp.Diff([]Point{{0, 0}, {0, 2}, {1, 1}}, FindAll(g, '#')); diff != "" {
+		t.Errorf("FindAll mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]Point{{0, 1}, {1, 0}, {1, 2}}, g.FindFunc(func(b byte) bool { return b != '#' })); diff != "" {
+		t.Errorf("FindFunc mismatch (-want +got):\n%s", diff)
+	}
+
+	if p, ok := Find(g, '.'); !ok || p != (Point{0, 1}) {
+		t.Errorf("Find: got %v, %t", p, ok)
+	}
+	if _, ok := Find(g, 'S'); ok {
+		t.Errorf("Find: found a missing value")
+	}
+}
+
+func TestString(t *testing.T) {
+	bools := New[bool](2, 2)
+	bools.Set(Point{0, 1}, true)
+	if got, want := bools.String(), ".#\n..\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	runes := New[rune](2, 1)
+	runes.Set(Point{0, 0}, '★')
+	runes.Set(Point{0, 1}, '·')
+	if got, want := runes.String(), "★·\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package grid
+
+// A Point is the position of a cell in a grid. Rows go down and columns go
+// right, starting from the top-left corner. Points also serve as directions
+// and as vectors in two dimensions.
+type Point struct {
+	Row, Col int
+}
+
+// Directions to neighboring cells.
+var (
+	Up        = Point{-1, 0}
+	Down      = Point{1, 0}
+	Left      = Point{0, -1}
+	Right     = Point{0, 1}
+	UpLeft    = Point{-1, -1}
+	UpRight   = Point{-1, 1}
+	DownLeft  = Point{1, -1}
+	DownRight = Point{1, 1}
+)
+
+// Directions4 holds the directions to the four orthogonal neighbors of a cell,
+// clockwise from Up.
+var Directions4 = [4]Point{Up, Right, Down, Left}
+
+// Diagonals holds the directions to the four diagonal neighbors of a cell,
+// clockwise from UpRight.
+var Diagonals = [4]Point{UpRight, DownRight, DownLeft, UpLeft}
+
+// Directions8 holds the directions to the eight neighbors of a cell, including
+// diagonals, clockwise from Up.
+var Directions8 = [8]Point{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+
+// Add returns the sum of p and q.
+func (p Point) Add(q Point) Point {
+	return Point{p.Row + q.Row, p.Col + q.Col}
+}
+
+// Sub returns the difference of p and q.
+func (p Point) Sub(q Point) Point {
+	return Point{p.Row - q.Row, p.Col - q.Col}
+}
+
+// Scale returns p multiplied by k.
+func (p Point) Scale(k int) Point {
+	return Point{p.Row * k, p.Col * k}
+}
+
+// Neg returns the opposite of p.
+func (p Point) Neg() Point {
+	return Point{-p.Row, -p.Col}
+}
+
+// Manhattan returns the Manhattan distance between p and q: the sum of the
+// absolute differences of their coordinates.
+func (p Point) Manhattan(q Point) int {
+	return abs(p.Row-q.Row) + abs(p.Col-q.Col)
+}
+
+// Chebyshev returns the Chebyshev distance between p and q: the largest
+// absolute difference of their coordinates. This is the number of moves a king
+// needs to go from p to q on a chessboard.
+func (p Point) Chebyshev(q Point) int {
+	return max(abs(p.Row-q.Row), abs(p.Col-q.Col))
+}
+
+// RotateClockwise returns p rotated by 90 degrees clockwise, so that Up
+// becomes Right.
+func (p Point) RotateClockwise() Point {
+	return Point{p.Col, -p.Row}
+}
+
+// RotateCounterClockwise returns p rotated by 90 degrees counter-clockwise, so
+// that Up becomes Left.
+func (p Point) RotateCounterClockwise() Point {
+	return Point{-p.Col, p.Row}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
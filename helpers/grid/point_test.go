@@ -0,0 +1,73 @@
+package grid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExamplePoint_RotateClockwise() {
+	pos, dir := Point{0, 0}, Up
+	for _, turn := range "RFRFFLF" {
+		switch turn {
+		case 'R':
+			dir = dir.RotateClockwise()
+		case 'L':
+			dir = dir.RotateCounterClockwise()
+		case 'F':
+			pos = pos.Add(dir)
+		}
+	}
+
+	fmt.Println(pos, pos.Manhattan(Point{}))
+	// Output: {2 2} 4
+}
+
+func TestPoint(t *testing.T) {
+	p, q := Point{3, -2}, Point{-1, 4}
+
+	if got, want := p.Add(q), (Point{2, 2}); got != want {
+		t.Errorf("Add: got %v, want %v", got, want)
+	}
+	if got, want := p.Sub(q), (Point{4, -6}); got != want {
+		t.Errorf("Sub: got %v, want %v", got, want)
+	}
+	if got, want := p.Scale(-2), (Point{-6, 4}); got != want {
+		t.Errorf("Scale: got %v, want %v", got, want)
+	}
+	if got, want := p.Neg(), (Point{-3, 2}); got != want {
+		t.Errorf("Neg: got %v, want %v", got, want)
+	}
+	if got, want := p.Manhattan(q), 10; got != want {
+		t.Errorf("Manhattan: got %d, want %d", got, want)
+	}
+	if got, want := p.Chebyshev(q), 6; got != want {
+		t.Errorf("Chebyshev: got %d, want %d", got, want)
+	}
+}
+
+func TestPointRotations(t *testing.T) {
+	for i, dir := range Directions4 {
+		next := Directions4[(i+1)%4]
+		if got := dir.RotateClockwise(); got != next {
+			t.Errorf("%v rotated clockwise: got %v, want %v", dir, got, next)
+		}
+		if got := next.RotateCounterClockwise(); got != dir {
+			t.Errorf("%v rotated counter-clockwise: got %v, want %v", next, got, dir)
+		}
+	}
+
+	for i, dir := range Directions8 {
+		if got, want := dir.RotateClockwise(), Directions8[(i+2)%8]; got != want {
+			t.Errorf("%v rotated clockwise: got %v, want %v", dir, got, want)
+		}
+		if got := dir.Chebyshev(Point{}); got != 1 {
+			t.Errorf("%v is not a unit direction", dir)
+		}
+	}
+
+	for i, dir := range Diagonals {
+		if got, want := dir, Directions8[2*i+1]; got != want {
+			t.Errorf("diagonal %d: got %v, want %v", i, got, want)
+		}
+	}
+}
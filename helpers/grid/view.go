@@ -0,0 +1,97 @@
+package grid
+
+import "iter"
+
+// A View is a straight line of cells of a grid, such as a row, a column, or a
+// diagonal. Changes made through a view change the grid.
+type View[T any] struct {
+	grid  *Grid[T]
+	start Point
+	step  Point
+	len   int
+}
+
+// Line returns a view of the cells of g from start, in the given direction,
+// up to the edge of g. The view is empty if start is out of bounds.
+func (g *Grid[T]) Line(start, dir Point) View[T] {
+	n := 0
+	for p := start; g.InBounds(p); p = p.Add(dir) {
+		n++
+		if dir == (Point{}) {
+			break
+		}
+	}
+	return View[T]{grid: g, start: start, step: dir, len: n}
+}
+
+// Row returns a view of the given row of g, from left to right.
+func (g *Grid[T]) Row(row int) View[T] {
+	return g.Line(Point{row, 0}, Right)
+}
+
+// Column returns a view of the given column of g, from top to bottom.
+func (g *Grid[T]) Column(col int) View[T] {
+	return g.Line(Point{0, col}, Down)
+}
+
+// Diagonal returns a view of the diagonal of g going down and right through
+// cells whose column minus row is k, from top to bottom. The main diagonal has
+// k = 0; diagonals range from 1-Height to Width-1.
+func (g *Grid[T]) Diagonal(k int) View[T] {
+	if k >= 0 {
+		return g.Line(Point{0, k}, DownRight)
+	}
+	return g.Line(Point{-k, 0}, DownRight)
+}
+
+// AntiDiagonal returns a view of the diagonal of g going down and left through
+// cells whose row plus column is k, from top to bottom. Anti-diagonals range
+// from 0 to Width+Height-2.
+func (g *Grid[T]) AntiDiagonal(k int) View[T] {
+	if k < g.width {
+		return g.Line(Point{0, k}, DownLeft)
+	}
+	return g.Line(Point{k - g.width + 1, g.width - 1}, DownLeft)
+}
+
+// Len returns the number of cells in v.
+func (v View[T]) Len() int {
+	return v.len
+}
+
+// Point returns the position in the grid of the i-th cell of v.
+func (v View[T]) Point(i int) Point {
+	return Point{v.start.Row + i*v.step.Row, v.start.Col + i*v.step.Col}
+}
+
+// At returns the value of the i-th cell of v.
+func (v View[T]) At(i int) T {
+	return v.grid.At(v.Point(i))
+}
+
+// Set sets the value of the i-th cell of v.
+func (v View[T]) Set(i int, value T) {
+	v.grid.Set(v.Point(i), value)
+}
+
+// All returns an iterator over the positions and values of the cells of v, in
+// order.
+func (v View[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i := 0; i < v.len; i++ {
+			p := v.Point(i)
+			if !yield(p, v.grid.At(p)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a copy of the values of the cells of v, in order.
+func (v View[T]) Values() []T {
+	values := make([]T, v.len)
+	for i := range values {
+		values[i] = v.At(i)
+	}
+	return values
+}
@@ -0,0 +1,72 @@
+package grid
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestViews(t *testing.T) {
+	g := mustParse(t, "abcd\nefgh\nijkl\n")
+
+	testCases := map[string]struct {
+		view View[byte]
+		want string
+	}{
+		"row":             {g.Row(1), "efgh"},
+		"column":          {g.Column(2), "cgk"},
+		"main_diagonal":   {g.Diagonal(0), "afk"},
+		"upper_diagonal":  {g.Diagonal(2), "ch"},
+		"lower_diagonal":  {g.Diagonal(-2), "i"},
+		"outside":         {g.Diagonal(4), ""},
+		"anti_diagonal":   {g.AntiDiagonal(2), "cfi"},
+		"last_anti":       {g.AntiDiagonal(5), "l"},
+		"lower_anti":      {g.AntiDiagonal(4), "hk"},
+		"line":            {g.Line(Point{2, 3}, Left), "lkji"},
+		"line_knight":     {g.Line(Point{0, 0}, Point{1, 2}), "ag"},
+		"line_standstill": {g.Line(Point{1, 1}, Point{}), "f"},
+	}
+	for name, tc := range testCases {
+		if got := string(tc.view.Values()); got != tc.want {
+			t.Errorf("%s: got %q, want %q", name, got, tc.want)
+		}
+		if tc.view.Len() != len(tc.want) {
+			t.Errorf("%s: got length %d, want %d", name, tc.view.Len(), len(tc.want))
+		}
+	}
+
+	// Every cell is on exactly one diagonal and one anti-diagonal.
+	var diagonals, antiDiagonals int
+	for k := 1 - g.Height(); k <= g.Width()-1; k++ {
+		diagonals += g.Diagonal(k).Len()
+	}
+	for k := 0; k <= g.Width()+g.Height()-2; k++ {
+		antiDiagonals += g.AntiDiagonal(k).Len()
+	}
+	if diagonals != 12 || antiDiagonals != 12 {
+		t.Errorf("diagonals cover %d and %d cells, want 12", diagonals, antiDiagonals)
+	}
+}
+
+func TestViewChangesGrid(t *testing.T) {
+	g := mustParse(t, "abc\ndef\n")
+
+	col := g.Column(1)
+	col.Set(1, 'x')
+	if got := g.At(Point{1, 1}); got != 'x' {
+		t.Errorf("got %q, want 'x'", got)
+	}
+	if got := col.At(1); got != 'x' {
+		t.Errorf("got %q from view, want 'x'", got)
+	}
+
+	var points []Point
+	for p, v := range g.Row(1).All() {
+		if v != 'x' {
+			points = append(points, p)
+		}
+	}
+	if diff := cmp.Diff([]Point{{1, 0}, {1, 2}}, points); diff != "" {
+		t.Errorf("All mismatch (-want +got):\n%s", diff)
+	}
+}
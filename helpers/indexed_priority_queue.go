@@ -0,0 +1,154 @@
+package helpers
+
+// An IndexedPriorityQueue is a priority queue whose items can be changed or
+// removed while queued, through the handle returned when they are pushed.
+// Search algorithms can then update an item's priority instead of pushing a
+// duplicate.
+type IndexedPriorityQueue[T any] struct {
+	items []*Handle[T]
+	less  func(a, b T) bool
+}
+
+// A Handle refers to an item pushed to an IndexedPriorityQueue.
+type Handle[T any] struct {
+	value T
+	index int // -1 once the item leaves the queue
+}
+
+// Value returns the item the handle refers to.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Queued reports whether the item is still in the queue.
+func (h *Handle[T]) Queued() bool {
+	return h.index >= 0
+}
+
+func NewIndexedPriorityQueue[T any](less func(a, b T) bool) *IndexedPriorityQueue[T] {
+	return &IndexedPriorityQueue[T]{
+		items: make([]*Handle[T], 0),
+		less:  less,
+	}
+}
+
+func (pq IndexedPriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Push adds x to the queue, and returns a handle to it.
+func (pq *IndexedPriorityQueue[T]) Push(x T) *Handle[T] {
+	h := &Handle[T]{value: x, index: len(pq.items)}
+	pq.items = append(pq.items, h)
+	pq.up(h.index)
+	return h
+}
+
+func (pq *IndexedPriorityQueue[T]) Pop() T {
+	if len(pq.items) == 0 {
+		panic("helpers: Pop called on an empty IndexedPriorityQueue")
+	}
+	return pq.remove(0)
+}
+
+// TryPop removes and returns the first item of the queue, or returns false if
+// the queue is empty.
+func (pq *IndexedPriorityQueue[T]) TryPop() (T, bool) {
+	if len(pq.items) == 0 {
+		return *new(T), false
+	}
+	return pq.remove(0), true
+}
+
+// Peek returns the first item of the queue without removing it, or returns
+// false if the queue is empty.
+func (pq IndexedPriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		return *new(T), false
+	}
+	return pq.items[0].value, true
+}
+
+// Update replaces the item h refers to with x, and moves it to its new place
+// in the queue. It panics if the item is no longer queued.
+func (pq *IndexedPriorityQueue[T]) Update(h *Handle[T], x T) {
+	if !h.Queued() {
+		panic("helpers: Update called with a handle to an item no longer queued")
+	}
+	h.value = x
+	if !pq.down(h.index) {
+		pq.up(h.index)
+	}
+}
+
+// Remove removes the item h refers to from the queue, and returns it. It panics
+// if the item is no longer queued.
+func (pq *IndexedPriorityQueue[T]) Remove(h *Handle[T]) T {
+	if !h.Queued() {
+		panic("helpers: Remove called with a handle to an item no longer queued")
+	}
+	return pq.remove(h.index)
+}
+
+// Clear removes all items from the queue, keeping its capacity.
+func (pq *IndexedPriorityQueue[T]) Clear() {
+	for _, h := range pq.items {
+		h.index = -1
+	}
+	clear(pq.items)
+	pq.items = pq.items[:0]
+}
+
+func (pq *IndexedPriorityQueue[T]) remove(i int) T {
+	n := len(pq.items) - 1
+	h := pq.items[i]
+	if i != n {
+		pq.swap(i, n)
+	}
+	pq.items[n] = nil
+	pq.items = pq.items[:n]
+	if i != n && !pq.down(i) {
+		pq.up(i)
+	}
+	h.index = -1
+	return h.value
+}
+
+func (pq *IndexedPriorityQueue[T]) up(j int) {
+	for {
+		i := (j - 1) / 2 // parent
+		if i == j || !pq.less(pq.items[j].value, pq.items[i].value) {
+			break
+		}
+		pq.swap(i, j)
+		j = i
+	}
+}
+
+// down moves the item at i0 down the heap, and reports whether it moved.
+func (pq *IndexedPriorityQueue[T]) down(i0 int) bool {
+	n := len(pq.items)
+	i := i0
+	for {
+		left := 2*i + 1
+		if left >= n || left < 0 { // left < 0 after int overflow
+			break
+		}
+		j := left // left child
+		if right := left + 1; right < n && pq.less(pq.items[right].value, pq.items[left].value) {
+			j = right // = 2*i + 2  // right child
+		}
+		if !pq.less(pq.items[j].value, pq.items[i].value) {
+			break
+		}
+		pq.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
+
+func (pq *IndexedPriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
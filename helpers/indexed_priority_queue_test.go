@@ -0,0 +1,51 @@
+package helpers
+
+import "testing"
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	pq := NewIndexedPriorityQueue(func(a, b int) bool { return a < b })
+
+	handles := make(map[int]*Handle[int])
+	for _, i := range []int{50, 30, 10, 20, 40, 70, 60, 90, 80, 100} {
+		handles[i] = pq.Push(i)
+	}
+
+	pq.Update(handles[90], 5)  // moves up
+	pq.Update(handles[10], 95) // moves down
+	pq.Update(handles[60], 60) // stays
+	if x := pq.Remove(handles[40]); x != 40 {
+		t.Errorf("Remove returned %d, want 40", x)
+	}
+	if handles[40].Queued() {
+		t.Errorf("removed item is still queued")
+	}
+	if x, ok := pq.Peek(); !ok || x != 5 {
+		t.Errorf("Peek: got %d, %t", x, ok)
+	}
+
+	expected := []int{5, 20, 30, 50, 60, 70, 80, 95, 100}
+	for _, e := range expected {
+		if actual := pq.Pop(); actual != e {
+			t.Fatalf("expected %d, got %d", e, actual)
+		}
+	}
+	if _, ok := pq.TryPop(); ok {
+		t.Errorf("TryPop on an empty queue returned an item")
+	}
+	if handles[100].Queued() || handles[100].Value() != 100 {
+		t.Errorf("popped handle is still queued, or lost its value")
+	}
+
+	h := pq.Push(1)
+	pq.Clear()
+	if pq.Len() != 0 || h.Queued() {
+		t.Errorf("Clear left %d items", pq.Len())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Update with a handle to a removed item did not panic")
+		}
+	}()
+	pq.Update(h, 2)
+}
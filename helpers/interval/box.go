@@ -0,0 +1,58 @@
+package interval
+
+// A Box is a rectangle, cuboid, or hyperrectangle: the points whose coordinates
+// are each in the interval of the matching dimension.
+type Box []Interval
+
+// Empty reports whether b holds no points.
+func (b Box) Empty() bool {
+	for _, i := range b {
+		if i.Empty() {
+			return true
+		}
+	}
+	return len(b) == 0
+}
+
+// Volume returns the number of points in b.
+func (b Box) Volume() int {
+	if b.Empty() {
+		return 0
+	}
+	v := 1
+	for _, i := range b {
+		v *= i.Len()
+	}
+	return v
+}
+
+// Contains reports whether the point with the given coordinates is in b.
+func (b Box) Contains(point ...int) bool {
+	if len(point) != len(b) {
+		return false
+	}
+	for d, i := range b {
+		if !i.Contains(point[d]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns the points in both b and c, which must have the same
+// number of dimensions. The result may be empty.
+func (b Box) Intersect(c Box) Box {
+	if len(b) != len(c) {
+		panic("interval: boxes have different dimensions")
+	}
+	result := make(Box, len(b))
+	for d := range b {
+		result[d] = b[d].Intersect(c[d])
+	}
+	return result
+}
+
+// Overlaps reports whether b and c have points in common.
+func (b Box) Overlaps(c Box) bool {
+	return !b.Intersect(c).Empty()
+}
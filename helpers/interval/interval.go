@@ -0,0 +1,77 @@
+// Package interval provides ranges of integers, sets of such ranges, and boxes
+// made of ranges in several dimensions.
+package interval
+
+import (
+	"fmt"
+	"math"
+)
+
+// An Interval holds the integers from Start included to End excluded. It is
+// empty if End is not greater than Start.
+type Interval struct {
+	Start, End int
+}
+
+// Closed returns the interval holding the integers from first to last, both
+// included. The interval is clamped to All: first and last are moved within
+// All's bounds, so integers beyond them are silently left out.
+func Closed(first, last int) Interval {
+	return Interval{max(first, All.Start), min(last, All.Last()) + 1}
+}
+
+// All holds the integers an Interval is meant to hold. It leaves out the
+// largest and smallest integers of type int, so that the length of any interval
+// within it, or of any set of such intervals, fits in an int.
+var All = Interval{math.MinInt / 2, math.MaxInt / 2}
+
+// Empty reports whether i holds no integers.
+func (i Interval) Empty() bool {
+	return i.End <= i.Start
+}
+
+// Len returns the number of integers in i. The result overflows if i is not
+// within All.
+func (i Interval) Len() int {
+	if i.Empty() {
+		return 0
+	}
+	return i.End - i.Start
+}
+
+// Last returns the greatest integer in i, which must not be empty.
+func (i Interval) Last() int {
+	return i.End - 1
+}
+
+// Contains reports whether x is in i.
+func (i Interval) Contains(x int) bool {
+	return i.Start <= x && x < i.End
+}
+
+// Intersect returns the integers that are in both i and j. The result may be
+// empty.
+func (i Interval) Intersect(j Interval) Interval {
+	return Interval{max(i.Start, j.Start), min(i.End, j.End)}
+}
+
+// Overlaps reports whether i and j have integers in common.
+func (i Interval) Overlaps(j Interval) bool {
+	return !i.Intersect(j).Empty()
+}
+
+// SplitAt splits i into the integers lower than x and the others. Either part
+// may be empty.
+func (i Interval) SplitAt(x int) (below, above Interval) {
+	x = min(max(x, i.Start), max(i.End, i.Start))
+	return Interval{i.Start, x}, Interval{x, i.End}
+}
+
+// Shift returns i with offset added to both of its ends.
+func (i Interval) Shift(offset int) Interval {
+	return Interval{i.Start + offset, i.End + offset}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d, %d)", i.Start, i.End)
+}
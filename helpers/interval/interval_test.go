@@ -0,0 +1,174 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleSet_Map() {
+	// Seeds 79 to 92 and 55 to 67, mapped to soil.
+	seeds := NewSet(Interval{79, 93}, Interval{55, 68})
+	seedToSoil := []Piece{
+		{From: Interval{98, 100}, Offset: -48},
+		{From: Interval{50, 98}, Offset: 2},
+	}
+
+	soil := seeds.Map(seedToSoil)
+	fmt.Println(soil, soil.Min())
+	// Output: {[57, 70), [81, 95)} 57
+}
+
+func TestInterval(t *testing.T) {
+	i := Closed(3, 7)
+
+	if i != (Interval{3, 8}) || i.Len() != 5 || i.Last() != 7 {
+		t.Errorf("Closed(3, 7) = %v, with length %d", i, i.Len())
+	}
+	if !i.Contains(3) || !i.Contains(7) || i.Contains(8) || i.Contains(2) {
+		t.Errorf("%v contains the wrong integers", i)
+	}
+
+	// Closed clamps its bounds to All.
+	if got, want := Closed(math.MinInt, math.MaxInt), All; got != want {
+		t.Errorf("Closed(math.MinInt, math.MaxInt) = %v, want %v", got, want)
+	}
+	if got, want := Closed(5, math.MaxInt), (Interval{5, All.End}); got != want || got.Contains(math.MaxInt) {
+		t.Errorf("Closed(5, math.MaxInt) = %v, want %v", got, want)
+	}
+	if All.Len() != math.MaxInt || NewSet(All).Len() != math.MaxInt {
+		t.Errorf("All has length %d, and its set %d", All.Len(), NewSet(All).Len())
+	}
+
+	empty := Interval{5, 5}
+	if !empty.Empty() || empty.Len() != 0 || (Interval{5, 2}).Len() != 0 {
+		t.Errorf("empty intervals have a length")
+	}
+
+	if got, want := i.Intersect(Interval{6, 20}), (Interval{6, 8}); got != want {
+		t.Errorf("Intersect: got %v, want %v", got, want)
+	}
+	if i.Overlaps(Interval{8, 10}) || !i.Overlaps(Interval{0, 4}) {
+		t.Errorf("Overlaps is wrong")
+	}
+	if got, want := i.Shift(-3), (Interval{0, 5}); got != want {
+		t.Errorf("Shift: got %v, want %v", got, want)
+	}
+
+	testCases := []struct {
+		x            int
+		below, above Interval
+	}{
+		{5, Interval{3, 5}, Interval{5, 8}},
+		{0, Interval{3, 3}, Interval{3, 8}},
+		{10, Interval{3, 8}, Interval{8, 8}},
+	}
+	for _, tc := range testCases {
+		below, above := i.SplitAt(tc.x)
+		if below != tc.below || above != tc.above {
+			t.Errorf("SplitAt(%d): got %v and %v, want %v and %v", tc.x, below, above, tc.below, tc.above)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	s := NewSet(Interval{10, 20}, Interval{0, 5}, Interval{5, 7}, Interval{15, 25}, Interval{30, 30})
+	u := NewSet(Interval{3, 12}, Interval{22, 40})
+
+	testCases := []struct {
+		name string
+		got  Set
+		want string
+	}{
+		{"new", s, "{[0, 7), [10, 25)}"},
+		{"union", s.Union(u), "{[0, 40)}"},
+		{"intersect", s.Intersect(u), "{[3, 7), [10, 12), [22, 25)}"},
+		{"difference", s.Difference(u), "{[0, 3), [12, 22)}"},
+		{"reverse_difference", u.Difference(s), "{[7, 10), [25, 40)}"},
+		{"difference_all", s.Difference(NewSet(All)), "{}"},
+		{"empty", Set{}.Union(NewSet()), "{}"},
+	}
+	for _, tc := range testCases {
+		if got := tc.got.String(); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+
+	if got, want := s.Len(), 22; got != want {
+		t.Errorf("Len: got %d, want %d", got, want)
+	}
+	for x, want := range map[int]bool{-1: false, 0: true, 6: true, 7: false, 9: false, 10: true, 24: true, 25: false} {
+		if got := s.Contains(x); got != want {
+			t.Errorf("Contains(%d): got %t, want %t", x, got, want)
+		}
+	}
+
+	below, above := s.SplitAt(12)
+	if below.String() != "{[0, 7), [10, 12)}" || above.String() != "{[12, 25)}" {
+		t.Errorf("SplitAt: got %v and %v", below, above)
+	}
+	if !below.Union(above).Equal(s) {
+		t.Errorf("the parts of a split do not make up the set")
+	}
+
+	// Splitting keeps integers beyond All.
+	huge := NewSet(Interval{math.MinInt, math.MinInt + 2}, Interval{math.MaxInt - 2, math.MaxInt})
+	below, above = huge.SplitAt(0)
+	if below.Len() != 2 || above.Len() != 2 {
+		t.Errorf("SplitAt(0) of %v: got %v and %v", huge, below, above)
+	}
+
+	// Sets are not changed by operations on them.
+	intervals := s.Intervals()
+	intervals[0].End = 100
+	if s.String() != "{[0, 7), [10, 25)}" {
+		t.Errorf("set changed to %v", s)
+	}
+}
+
+func TestSetMap(t *testing.T) {
+	s := NewSet(Interval{0, 10})
+
+	testCases := []struct {
+		name   string
+		pieces []Piece
+		want   string
+	}{
+		{"none", nil, "{[0, 10)}"},
+		{"inside", []Piece{{Interval{2, 4}, 100}}, "{[0, 2), [4, 10), [102, 104)}"},
+		{"merge", []Piece{{Interval{5, 10}, -5}}, "{[0, 5)}"},
+		{"swap", []Piece{{Interval{0, 5}, 5}, {Interval{5, 10}, -5}}, "{[0, 10)}"},
+		{"first_wins", []Piece{{Interval{0, 4}, 20}, {Interval{2, 6}, 40}}, "{[6, 10), [20, 24), [44, 46)}"},
+	}
+	for _, tc := range testCases {
+		if got := s.Map(tc.pieces).String(); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestBox(t *testing.T) {
+	a := Box{Closed(10, 12), Closed(10, 12), Closed(10, 12)}
+	b := Box{Closed(11, 13), Closed(11, 13), Closed(11, 13)}
+
+	if got, want := a.Volume(), 27; got != want {
+		t.Errorf("Volume: got %d, want %d", got, want)
+	}
+
+	i := a.Intersect(b)
+	if got, want := i.Volume(), 8; got != want {
+		t.Errorf("intersection volume: got %d, want %d", got, want)
+	}
+	if !i.Contains(11, 12, 11) || i.Contains(10, 11, 11) || i.Contains(11, 11) {
+		t.Errorf("intersection %v contains the wrong points", i)
+	}
+
+	far := Box{Closed(0, 1), Closed(0, 1), Closed(20, 30)}
+	if a.Overlaps(far) || a.Intersect(far).Volume() != 0 || !a.Overlaps(b) {
+		t.Errorf("Overlaps is wrong")
+	}
+
+	if (Box{}).Volume() != 0 || !(Box{}).Empty() {
+		t.Errorf("a box without dimensions has a volume")
+	}
+}
@@ -0,0 +1,171 @@
+package interval
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// A Set is a set of integers, stored as sorted intervals that neither overlap
+// nor touch. The zero value is an empty set. Sets are never modified once
+// created.
+type Set struct {
+	intervals []Interval
+}
+
+// NewSet returns the set of integers in any of intervals.
+func NewSet(intervals ...Interval) Set {
+	return Set{normalize(append([]Interval(nil), intervals...))}
+}
+
+// normalize sorts intervals and merges those that overlap or touch, in place.
+func normalize(intervals []Interval) []Interval {
+	intervals = slices.DeleteFunc(intervals, Interval.Empty)
+	slices.SortFunc(intervals, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	merged := intervals[:0]
+	for _, i := range intervals {
+		if n := len(merged); n > 0 && i.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, i.End)
+			continue
+		}
+		merged = append(merged, i)
+	}
+
+	return merged
+}
+
+// Intervals returns the intervals of s, sorted. They neither overlap nor
+// touch.
+func (s Set) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+// Empty reports whether s holds no integers.
+func (s Set) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Len returns the number of integers in s.
+func (s Set) Len() int {
+	n := 0
+	for _, i := range s.intervals {
+		n += i.Len()
+	}
+	return n
+}
+
+// Min returns the lowest integer in s, which must not be empty.
+func (s Set) Min() int {
+	return s.intervals[0].Start
+}
+
+// Contains reports whether x is in s.
+func (s Set) Contains(x int) bool {
+	i, found := slices.BinarySearchFunc(s.intervals, x, func(i Interval, x int) int {
+		switch {
+		case i.End <= x:
+			return -1
+		case i.Start > x:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return found && s.intervals[i].Contains(x)
+}
+
+// Union returns the integers in s or t.
+func (s Set) Union(t Set) Set {
+	return Set{normalize(slices.Concat(s.intervals, t.intervals))}
+}
+
+// Intersect returns the integers in both s and t.
+func (s Set) Intersect(t Set) Set {
+	var result []Interval
+	for a, b := 0, 0; a < len(s.intervals) && b < len(t.intervals); {
+		i, j := s.intervals[a], t.intervals[b]
+		if k := i.Intersect(j); !k.Empty() {
+			result = append(result, k)
+		}
+		if i.End < j.End {
+			a++
+		} else {
+			b++
+		}
+	}
+	return Set{result}
+}
+
+// Difference returns the integers in s but not in t.
+func (s Set) Difference(t Set) Set {
+	var result []Interval
+	b := 0
+	for _, i := range s.intervals {
+		for b < len(t.intervals) && t.intervals[b].End <= i.Start {
+			b++
+		}
+		for k := b; k < len(t.intervals) && t.intervals[k].Start < i.End; k++ {
+			j := t.intervals[k]
+			if j.Start > i.Start {
+				result = append(result, Interval{i.Start, j.Start})
+			}
+			i.Start = j.End
+		}
+		if !i.Empty() {
+			result = append(result, i)
+		}
+	}
+	return Set{result}
+}
+
+// SplitAt splits s into the integers lower than x and the others.
+func (s Set) SplitAt(x int) (below, above Set) {
+	for _, i := range s.intervals {
+		b, a := i.SplitAt(x)
+		if !b.Empty() {
+			below.intervals = append(below.intervals, b)
+		}
+		if !a.Empty() {
+			above.intervals = append(above.intervals, a)
+		}
+	}
+	return below, above
+}
+
+// A Piece of a piecewise-linear mapping shifts the integers of From by Offset.
+type Piece struct {
+	From   Interval
+	Offset int
+}
+
+// Map returns the integers of s mapped through pieces. Integers in the From
+// interval of a piece are shifted by its offset, and others are kept as is.
+// When pieces overlap, the first one applies.
+func (s Set) Map(pieces []Piece) Set {
+	var mapped []Interval
+	remaining := s
+	for _, p := range pieces {
+		from := NewSet(p.From)
+		for _, i := range remaining.Intersect(from).intervals {
+			mapped = append(mapped, i.Shift(p.Offset))
+		}
+		remaining = remaining.Difference(from)
+	}
+	return Set{normalize(append(mapped, remaining.intervals...))}
+}
+
+// Equal reports whether s and t hold the same integers.
+func (s Set) Equal(t Set) bool {
+	return slices.Equal(s.intervals, t.intervals)
+}
+
+func (s Set) String() string {
+	parts := make([]string, len(s.intervals))
+	for k, i := range s.intervals {
+		parts[k] = i.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
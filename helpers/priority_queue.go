@@ -12,6 +12,19 @@ func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
 	}
 }
 
+// NewPriorityQueueFrom returns a priority queue holding items, built in linear
+// time. The queue takes ownership of items.
+func NewPriorityQueueFrom[T any](less func(a, b T) bool, items []T) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{
+		items: items,
+		less:  less,
+	}
+	for i := len(items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+	return pq
+}
+
 func (pq PriorityQueue[T]) Len() int {
 	return len(pq.items)
 }
@@ -23,6 +36,9 @@ func (pq *PriorityQueue[T]) Push(x T) {
 
 func (pq *PriorityQueue[T]) Pop() T {
 	n := len(pq.items)
+	if n == 0 {
+		panic("helpers: Pop called on an empty PriorityQueue")
+	}
 	pq.swap(0, n-1)
 	popped := pq.items[n-1]
 	pq.items[n-1] = *new(T)
@@ -31,6 +47,30 @@ func (pq *PriorityQueue[T]) Pop() T {
 	return popped
 }
 
+// TryPop removes and returns the first item of the queue, or returns false if
+// the queue is empty.
+func (pq *PriorityQueue[T]) TryPop() (T, bool) {
+	if len(pq.items) == 0 {
+		return *new(T), false
+	}
+	return pq.Pop(), true
+}
+
+// Peek returns the first item of the queue without removing it, or returns
+// false if the queue is empty.
+func (pq PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		return *new(T), false
+	}
+	return pq.items[0], true
+}
+
+// Clear removes all items from the queue, keeping its capacity.
+func (pq *PriorityQueue[T]) Clear() {
+	clear(pq.items)
+	pq.items = pq.items[:0]
+}
+
 func (pq *PriorityQueue[T]) up(j int) {
 	for {
 		i := (j - 1) / 2 // parent
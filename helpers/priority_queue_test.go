@@ -1,6 +1,9 @@
 package helpers
 
-import "testing"
+import (
+	"math/rand/v2"
+	"testing"
+)
 
 func TestPriorityQueue(t *testing.T) {
 	testCases := []struct {
@@ -75,3 +78,219 @@ func TestPriorityQueue(t *testing.T) {
 		})
 	}
 }
+
+func TestPriorityQueueEmpty(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b int) bool { return a < b })
+
+	if _, ok := pq.Peek(); ok {
+		t.Errorf("Peek on an empty queue returned an item")
+	}
+	if _, ok := pq.TryPop(); ok {
+		t.Errorf("TryPop on an empty queue returned an item")
+	}
+
+	pq.Push(2)
+	pq.Push(1)
+	if x, ok := pq.Peek(); !ok || x != 1 || pq.Len() != 2 {
+		t.Errorf("Peek: got %d, %t with %d items", x, ok, pq.Len())
+	}
+	if x, ok := pq.TryPop(); !ok || x != 1 || pq.Len() != 1 {
+		t.Errorf("TryPop: got %d, %t with %d items", x, ok, pq.Len())
+	}
+
+	pq.Clear()
+	if pq.Len() != 0 {
+		t.Errorf("got %d items after Clear", pq.Len())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pop on an empty queue did not panic")
+		}
+	}()
+	pq.Pop()
+}
+
+func TestNewPriorityQueueFrom(t *testing.T) {
+	input := []int{5, 3, 1, 2, 4, 7, 6, 9, 8, 10, 3}
+	expected := []int{1, 2, 3, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	pq := NewPriorityQueueFrom(func(a, b int) bool { return a < b }, input)
+	pq.Push(0)
+
+	if actual := pq.Pop(); actual != 0 {
+		t.Fatalf("expected 0, got %d", actual)
+	}
+	for _, e := range expected {
+		if actual := pq.Pop(); actual != e {
+			t.Fatalf("expected %d, got %d", e, actual)
+		}
+	}
+}
+
+// benchmarkGraph returns a grid of random edge costs between 1 and 9, always
+// the same.
+func benchmarkGraph(size int) [][]int {
+	rng := rand.New(rand.NewPCG(2024, 12))
+	costs := make([][]int, size)
+	for row := range costs {
+		costs[row] = make([]int, size)
+		for col := range costs[row] {
+			costs[row][col] = 1 + rng.IntN(9)
+		}
+	}
+	return costs
+}
+
+type benchmarkState struct {
+	row, col, dist int
+}
+
+func benchmarkNeighbors(costs [][]int, s benchmarkState, visit func(row, col, dist int)) {
+	size := len(costs)
+	for _, d := range [4][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}} {
+		row, col := s.row+d[0], s.col+d[1]
+		if row >= 0 && row < size && col >= 0 && col < size {
+			visit(row, col, s.dist+costs[row][col])
+		}
+	}
+}
+
+func lessDist(a, b benchmarkState) bool {
+	return a.dist < b.dist
+}
+
+func dijkstraWithPriorityQueue(costs [][]int) int {
+	size := len(costs)
+	dist := make([]int, size*size)
+	for i := range dist {
+		dist[i] = -1
+	}
+	pq := NewPriorityQueue(lessDist)
+	pq.Push(benchmarkState{0, 0, 0})
+	dist[0] = 0
+
+	for pq.Len() > 0 {
+		s := pq.Pop()
+		if s.dist > dist[s.row*size+s.col] {
+			continue // duplicate
+		}
+		benchmarkNeighbors(costs, s, func(row, col, d int) {
+			if i := row*size + col; dist[i] < 0 || d < dist[i] {
+				dist[i] = d
+				pq.Push(benchmarkState{row, col, d})
+			}
+		})
+	}
+
+	return dist[len(dist)-1]
+}
+
+func dijkstraWithIndexedPriorityQueue(costs [][]int) int {
+	size := len(costs)
+	handles := make([]*Handle[benchmarkState], size*size)
+	dist := make([]int, size*size)
+	for i := range dist {
+		dist[i] = -1
+	}
+	pq := NewIndexedPriorityQueue(lessDist)
+	handles[0] = pq.Push(benchmarkState{0, 0, 0})
+	dist[0] = 0
+
+	for pq.Len() > 0 {
+		s := pq.Pop()
+		benchmarkNeighbors(costs, s, func(row, col, d int) {
+			i := row*size + col
+			switch {
+			case dist[i] < 0:
+				dist[i] = d
+				handles[i] = pq.Push(benchmarkState{row, col, d})
+			case d < dist[i]:
+				dist[i] = d
+				pq.Update(handles[i], benchmarkState{row, col, d})
+			}
+		})
+	}
+
+	return dist[len(dist)-1]
+}
+
+func dijkstraWithBucketQueue(costs [][]int) int {
+	size := len(costs)
+	dist := make([]int, size*size)
+	for i := range dist {
+		dist[i] = -1
+	}
+	bq := NewBucketQueue[benchmarkState]()
+	bq.Push(benchmarkState{0, 0, 0}, 0)
+	dist[0] = 0
+
+	for bq.Len() > 0 {
+		s, _ := bq.Pop()
+		if s.dist > dist[s.row*size+s.col] {
+			continue // duplicate
+		}
+		benchmarkNeighbors(costs, s, func(row, col, d int) {
+			if i := row*size + col; dist[i] < 0 || d < dist[i] {
+				dist[i] = d
+				bq.Push(benchmarkState{row, col, d}, d)
+			}
+		})
+	}
+
+	return dist[len(dist)-1]
+}
+
+// BenchmarkDijkstra finds the lowest total cost from one corner of a grid to
+// the other with each kind of queue.
+func BenchmarkDijkstra(b *testing.B) {
+	costs := benchmarkGraph(150)
+	want := dijkstraWithPriorityQueue(costs)
+
+	benchmarks := []struct {
+		name     string
+		dijkstra func([][]int) int
+	}{
+		{"priority-queue", dijkstraWithPriorityQueue},
+		{"indexed-priority-queue", dijkstraWithIndexedPriorityQueue},
+		{"bucket-queue", dijkstraWithBucketQueue},
+	}
+
+	for _, bench := range benchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				if got := bench.dijkstra(costs); got != want {
+					b.Fatalf("got distance %d, want %d", got, want)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkBuild compares building a queue from a slice with pushing items one
+// by one.
+func BenchmarkBuild(b *testing.B) {
+	rng := rand.New(rand.NewPCG(2024, 12))
+	input := make([]int, 100_000)
+	for i := range input {
+		input[i] = rng.Int()
+	}
+	less := func(a, b int) bool { return a < b }
+	items := make([]int, len(input))
+
+	b.Run("push", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			pq := NewPriorityQueue(less)
+			for _, x := range input {
+				pq.Push(x)
+			}
+		}
+	})
+
+	b.Run("from-slice", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			copy(items, input)
+			_ = NewPriorityQueueFrom(less, items)
+		}
+	})
+}
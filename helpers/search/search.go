@@ -0,0 +1,224 @@
+// Package search finds shortest paths in graphs whose states are generated on
+// the fly, such as positions in a maze or configurations of a puzzle.
+package search
+
+import "github.com/busser/adventofcode/helpers"
+
+// An Edge leads to a state, at a cost. Costs must not be negative.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// A Result holds what a search learned about the states it reached.
+type Result[S comparable] struct {
+	// Dist holds the length of the shortest path from a start to each state
+	// reached. States that were reached but not fully explored may have a
+	// shorter path when the search stops early.
+	Dist map[S]int
+
+	// Prev holds, for each state reached, the states preceding it on its
+	// shortest paths. Starts have none.
+	Prev map[S][]S
+
+	// Goal is the first state found for which the goal function returned true,
+	// if Found is true.
+	Goal  S
+	Found bool
+}
+
+func newResult[S comparable]() Result[S] {
+	return Result[S]{
+		Dist: make(map[S]int),
+		Prev: make(map[S][]S),
+	}
+}
+
+// Distance returns the length of the shortest path from a start to s, and
+// false if s was not reached.
+func (r Result[S]) Distance(s S) (int, bool) {
+	d, ok := r.Dist[s]
+	return d, ok
+}
+
+// Path returns one of the shortest paths from a start to s, both included, or
+// nil if s was not reached.
+func (r Result[S]) Path(s S) []S {
+	if _, ok := r.Dist[s]; !ok {
+		return nil
+	}
+
+	// Searches never record predecessors of a start, but a Result built by
+	// hand may hold a cycle, which must not make Path loop forever.
+	path := []S{s}
+	seen := map[S]bool{s: true}
+	for prev := r.Prev[s]; len(prev) > 0 && !seen[prev[0]]; prev = r.Prev[prev[0]] {
+		path = append(path, prev[0])
+		seen[prev[0]] = true
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// OnShortestPaths returns the set of states that are on at least one of the
+// shortest paths from a start to any of targets, targets included.
+func (r Result[S]) OnShortestPaths(targets ...S) map[S]bool {
+	best := -1
+	for _, t := range targets {
+		if d, ok := r.Dist[t]; ok && (best < 0 || d < best) {
+			best = d
+		}
+	}
+
+	on := make(map[S]bool)
+	var todo []S
+	for _, t := range targets {
+		if d, ok := r.Dist[t]; ok && d == best && !on[t] {
+			on[t] = true
+			todo = append(todo, t)
+		}
+	}
+
+	for len(todo) > 0 {
+		s := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+
+		for _, p := range r.Prev[s] {
+			if !on[p] {
+				on[p] = true
+				todo = append(todo, p)
+			}
+		}
+	}
+
+	return on
+}
+
+// BFS explores states breadth-first from starts, where every step costs one.
+// It stops once it reaches a state for which goal returns true, or once every
+// reachable state is explored. A nil goal explores every reachable state.
+func BFS[S comparable](neighbors func(S) []S, goal func(S) bool, starts ...S) Result[S] {
+	r := newResult[S]()
+
+	var queue []S
+	for _, s := range starts {
+		if _, ok := r.Dist[s]; !ok {
+			r.Dist[s] = 0
+			queue = append(queue, s)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if goal != nil && goal(current) {
+			r.Goal, r.Found = current, true
+			return r
+		}
+
+		dist := r.Dist[current] + 1
+		for _, next := range neighbors(current) {
+			d, ok := r.Dist[next]
+			switch {
+			case !ok:
+				r.Dist[next] = dist
+				r.Prev[next] = []S{current}
+				queue = append(queue, next)
+			case d == dist:
+				r.Prev[next] = append(r.Prev[next], current)
+			}
+		}
+	}
+
+	return r
+}
+
+// Dijkstra explores states from starts in order of distance, following edges
+// returned by neighbors. It stops once it has found a state for which goal
+// returns true, along with all shortest paths to it, or once every reachable
+// state is explored. A nil goal explores every reachable state.
+func Dijkstra[S comparable](neighbors func(S) []Edge[S], goal func(S) bool, starts ...S) Result[S] {
+	return AStar(neighbors, nil, goal, starts...)
+}
+
+// AStar is like Dijkstra, but explores first the states that heuristic
+// estimates to be closest to a goal. The estimate must never exceed the actual
+// distance to the nearest goal, and must not decrease by more than the cost of
+// an edge along it, otherwise paths found may not be the shortest. A nil
+// heuristic behaves like Dijkstra.
+func AStar[S comparable](neighbors func(S) []Edge[S], heuristic func(S) int, goal func(S) bool, starts ...S) Result[S] {
+	type item struct {
+		state    S
+		dist     int
+		priority int
+	}
+
+	estimate := func(s S, dist int) item {
+		if heuristic == nil {
+			return item{s, dist, dist}
+		}
+		return item{s, dist, dist + heuristic(s)}
+	}
+
+	r := newResult[S]()
+	queue := helpers.NewPriorityQueue(func(a, b item) bool {
+		return a.priority < b.priority
+	})
+
+	// States whose distance is known: starts, and states already explored.
+	// Edges that cost nothing never add a predecessor to them, otherwise a
+	// state could precede itself, and Path would never reach a start.
+	final := make(map[S]bool)
+
+	for _, s := range starts {
+		if _, ok := r.Dist[s]; !ok {
+			r.Dist[s] = 0
+			final[s] = true
+			queue.Push(estimate(s, 0))
+		}
+	}
+
+	for queue.Len() > 0 {
+		current := queue.Pop()
+
+		// A shorter path to this state was found after it was queued.
+		if current.dist > r.Dist[current.state] {
+			continue
+		}
+		final[current.state] = true
+
+		// Keep going until no other path can be as short, so that Prev holds
+		// every shortest path to the goal.
+		if r.Found {
+			if current.priority > r.Dist[r.Goal] {
+				break
+			}
+		} else if goal != nil && goal(current.state) {
+			r.Goal, r.Found = current.state, true
+		}
+
+		for _, e := range neighbors(current.state) {
+			if e.Cost == 0 && final[e.To] {
+				continue
+			}
+
+			dist := current.dist + e.Cost
+			d, ok := r.Dist[e.To]
+			switch {
+			case !ok || dist < d:
+				r.Dist[e.To] = dist
+				r.Prev[e.To] = []S{current.state}
+				queue.Push(estimate(e.To, dist))
+			case dist == d:
+				r.Prev[e.To] = append(r.Prev[e.To], current.state)
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,246 @@
+package search
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/busser/adventofcode/helpers/grid"
+)
+
+const maze = `###############
+#.......#....E#
+#.#.###.#.###.#
+#.....#.#...#.#
+#.###.#####.#.#
+#.#.#.......#.#
+#.#.#####.###.#
+#...........#.#
+###.#.#####.#.#
+#...#.....#.#.#
+#.#.#.###.#.#.#
+#.....#...#.#.#
+#.###.#.#.#.#.#
+#S..#.....#...#
+###############
+`
+
+func parseMaze(t testing.TB) (g *grid.Grid[byte], start, end grid.Point) {
+	t.Helper()
+
+	g, err := grid.FromReader(strings.NewReader(maze))
+	if err != nil {
+		t.Fatalf("parsing maze: %v", err)
+	}
+	start, _ = grid.Find(g, 'S')
+	end, _ = grid.Find(g, 'E')
+
+	return g, start, end
+}
+
+func openNeighbors(g *grid.Grid[byte]) func(grid.Point) []grid.Point {
+	return func(p grid.Point) []grid.Point {
+		var open []grid.Point
+		for q := range g.Neighbors4(p) {
+			if g.At(q) != '#' {
+				open = append(open, q)
+			}
+		}
+		return open
+	}
+}
+
+func ExampleDijkstra() {
+	roads := map[string][]Edge[string]{
+		"home":   {{"bakery", 4}, {"park", 1}},
+		"park":   {{"bakery", 2}, {"office", 7}},
+		"bakery": {{"office", 3}},
+	}
+	neighbors := func(place string) []Edge[string] {
+		return roads[place]
+	}
+	atOffice := func(place string) bool {
+		return place == "office"
+	}
+
+	r := Dijkstra(neighbors, atOffice, "home")
+	if !r.Found {
+		log.Fatal("no path to the office")
+	}
+
+	fmt.Println(r.Dist[r.Goal], r.Path(r.Goal))
+	// Output: 6 [home park bakery office]
+}
+
+func TestBFS(t *testing.T) {
+	g, start, end := parseMaze(t)
+	neighbors := openNeighbors(g)
+
+	r := BFS(neighbors, func(p grid.Point) bool { return p == end }, start)
+	if !r.Found || r.Goal != end {
+		t.Fatalf("did not find the end")
+	}
+	if got, want := r.Dist[end], 28; got != want {
+		t.Errorf("got distance %d, want %d", got, want)
+	}
+
+	path := r.Path(end)
+	if len(path) != r.Dist[end]+1 || path[0] != start || path[len(path)-1] != end {
+		t.Fatalf("got invalid path %v", path)
+	}
+	for i := 1; i < len(path); i++ {
+		if path[i].Manhattan(path[i-1]) != 1 {
+			t.Fatalf("path jumps from %v to %v", path[i-1], path[i])
+		}
+	}
+
+	// Without a goal, every open cell is reached.
+	all := BFS(neighbors, nil, start)
+	open := len(g.FindFunc(func(b byte) bool { return b != '#' }))
+	if all.Found || len(all.Dist) != open {
+		t.Errorf("reached %d cells, want %d", len(all.Dist), open)
+	}
+}
+
+func TestBFSShortestPaths(t *testing.T) {
+	// Two shortest paths from a to d, and a longer one.
+	graph := map[string][]string{
+		"a": {"b", "c", "e"},
+		"b": {"d"},
+		"c": {"d"},
+		"e": {"f"},
+		"f": {"d"},
+	}
+	neighbors := func(s string) []string { return graph[s] }
+
+	r := BFS(neighbors, nil, "a")
+
+	if diff := cmp.Diff([]string{"b", "c"}, r.Prev["d"]); diff != "" {
+		t.Errorf("predecessors mismatch (-want +got):\n%s", diff)
+	}
+	want := map[string]bool{"a": true, "b": true, "c": true, "d": true}
+	if diff := cmp.Diff(want, r.OnShortestPaths("d")); diff != "" {
+		t.Errorf("states on shortest paths mismatch (-want +got):\n%s", diff)
+	}
+
+	if r.Path("z") != nil || len(r.OnShortestPaths("z")) != 0 {
+		t.Errorf("found a path to an unreachable state")
+	}
+}
+
+// A reindeer moves forward for 1 point and turns for 1000 points.
+type reindeer struct {
+	pos, dir grid.Point
+}
+
+func reindeerMoves(g *grid.Grid[byte]) func(reindeer) []Edge[reindeer] {
+	return func(r reindeer) []Edge[reindeer] {
+		edges := []Edge[reindeer]{
+			{reindeer{r.pos, r.dir.RotateClockwise()}, 1000},
+			{reindeer{r.pos, r.dir.RotateCounterClockwise()}, 1000},
+		}
+		if next := r.pos.Add(r.dir); g.At(next) != '#' {
+			edges = append(edges, Edge[reindeer]{reindeer{next, r.dir}, 1})
+		}
+		return edges
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	g, start, end := parseMaze(t)
+	atEnd := func(r reindeer) bool { return r.pos == end }
+
+	r := Dijkstra(reindeerMoves(g), atEnd, reindeer{start, grid.Right})
+	if !r.Found {
+		t.Fatalf("did not find the end")
+	}
+	if got, want := r.Dist[r.Goal], 7036; got != want {
+		t.Errorf("got distance %d, want %d", got, want)
+	}
+
+	var ends []reindeer
+	for _, dir := range grid.Directions4 {
+		ends = append(ends, reindeer{end, dir})
+	}
+	tiles := make(map[grid.Point]bool)
+	for state := range r.OnShortestPaths(ends...) {
+		tiles[state.pos] = true
+	}
+	if got, want := len(tiles), 45; got != want {
+		t.Errorf("got %d tiles on best paths, want %d", got, want)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	g, start, end := parseMaze(t)
+
+	neighbors := func(p grid.Point) []Edge[grid.Point] {
+		var edges []Edge[grid.Point]
+		for _, q := range openNeighbors(g)(p) {
+			edges = append(edges, Edge[grid.Point]{q, 1})
+		}
+		return edges
+	}
+	manhattan := func(p grid.Point) int { return p.Manhattan(end) }
+	atEnd := func(p grid.Point) bool { return p == end }
+
+	bfs := BFS(openNeighbors(g), atEnd, start)
+	dijkstra := Dijkstra(neighbors, atEnd, start)
+	astar := AStar(neighbors, manhattan, atEnd, start)
+
+	if !astar.Found || astar.Dist[end] != bfs.Dist[end] || dijkstra.Dist[end] != bfs.Dist[end] {
+		t.Fatalf("got distances %d and %d, want %d", astar.Dist[end], dijkstra.Dist[end], bfs.Dist[end])
+	}
+	if diff := cmp.Diff(bfs.OnShortestPaths(end), astar.OnShortestPaths(end)); diff != "" {
+		t.Errorf("states on shortest paths mismatch (-bfs +astar):\n%s", diff)
+	}
+	if len(astar.Dist) >= len(dijkstra.Dist) {
+		t.Errorf("A* reached %d states, no fewer than Dijkstra's %d", len(astar.Dist), len(dijkstra.Dist))
+	}
+}
+
+func TestMultipleStarts(t *testing.T) {
+	neighbors := func(n int) []Edge[int] {
+		return []Edge[int]{{n + 1, 1}, {n * 2, 1}}
+	}
+	isTarget := func(n int) bool { return n == 100 }
+
+	r := Dijkstra(neighbors, isTarget, 1, 49)
+	if got, want := r.Path(100), []int{49, 50, 100}; !cmp.Equal(got, want) {
+		t.Errorf("got path %v, want %v", got, want)
+	}
+}
+
+func TestZeroCostCycle(t *testing.T) {
+	edges := map[string][]Edge[string]{
+		"a": {{"b", 0}},
+		"b": {{"a", 0}, {"c", 1}},
+		"c": {{"d", 0}},
+		"d": {{"c", 0}},
+	}
+	neighbors := func(s string) []Edge[string] { return edges[s] }
+
+	r := Dijkstra(neighbors, nil, "a")
+	if prev := r.Prev["a"]; len(prev) > 0 {
+		t.Errorf("start has predecessors %v", prev)
+	}
+	for s, want := range map[string][]string{
+		"a": {"a"},
+		"b": {"a", "b"},
+		"c": {"a", "b", "c"},
+		"d": {"a", "b", "c", "d"},
+	} {
+		if got := r.Path(s); !cmp.Equal(got, want) {
+			t.Errorf("path to %s: got %v, want %v", s, got, want)
+		}
+	}
+
+	// Paths stop at a cycle in a Result built by hand.
+	r.Prev["a"] = []string{"b"}
+	if got, want := r.Path("c"), []string{"a", "b", "c"}; !cmp.Equal(got, want) {
+		t.Errorf("path through a cycle: got %v, want %v", got, want)
+	}
+}
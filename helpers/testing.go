@@ -2,7 +2,11 @@ package helpers
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -54,3 +58,70 @@ func BenchmarkSolution(b *testing.B, s Solution, inputFile string) {
 		_ = s.Solve(r, w)
 	}
 }
+
+// Profiles returns the names of profiles with an input in the given testdata
+// directory. The input of a profile is stored in <testdata>/<profile>/input.txt.
+func Profiles(testdata string) ([]string, error) {
+	entries, err := os.ReadDir(testdata)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(testdata, entry.Name(), "input.txt"))
+		if err == nil && info.Size() > 0 {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+
+	return profiles, nil
+}
+
+// TestProfiles tests solutions against the input of every profile found in the
+// testdata directory. Answers maps each profile to the expected answers of
+// solutions, in order. Solutions whose answer is unknown are skipped.
+func TestProfiles(t *testing.T, solutions []Solution, answers map[string][]string) {
+	t.Helper()
+
+	profiles, err := Profiles("testdata")
+	if err != nil {
+		t.Fatalf("could not list profiles: %v", err)
+	}
+
+	for _, profile := range profiles {
+		inputFile := filepath.Join("testdata", profile, "input.txt")
+
+		for i, s := range solutions {
+			t.Run(fmt.Sprintf("%s/part%d", profile, i+1), func(t *testing.T) {
+				var answer string
+				if i < len(answers[profile]) {
+					answer = answers[profile][i]
+				}
+				if answer == "" {
+					t.Skipf("answer of %s unknown", profile)
+				}
+
+				input, err := ioutil.ReadFile(inputFile)
+				if err != nil {
+					t.Fatalf("could not read input file: %v", err)
+				}
+
+				w := &bytes.Buffer{}
+				if err := s.Solve(bytes.NewReader(input), w); err != nil {
+					t.Fatalf("error running solution: %v", err)
+				}
+
+				if actual := strings.TrimSpace(w.String()); actual != answer {
+					t.Fatalf("did not get expected answer:\n\texpected: %q\n\tgot: %q", answer, actual)
+				}
+			})
+		}
+	}
+}
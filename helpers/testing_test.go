@@ -0,0 +1,44 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestProfilesWithInput(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"input.txt":       "default input",
+		"alice/input.txt": "input of alice",
+		"bob/input.txt":   "",
+		"carol/notes.txt": "no input",
+		"dave/input.txt":  "input of dave",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	profiles, err := Profiles(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"alice", "dave"}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("got profiles %v, want %v", profiles, want)
+	}
+
+	profiles, err = Profiles(filepath.Join(dir, "missing"))
+	if err != nil || len(profiles) != 0 {
+		t.Errorf("got %v, %v for missing directory", profiles, err)
+	}
+}
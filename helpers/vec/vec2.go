@@ -0,0 +1,10 @@
+// Package vec provides integer vectors in two and three dimensions, and
+// vectors of any dimension, as found in many Advent of Code puzzles.
+package vec
+
+import "github.com/busser/adventofcode/helpers/grid"
+
+// A Vec2 is a vector in two dimensions. It is the same type as grid.Point,
+// whose package also holds the directions to neighboring cells, so that
+// positions found in a grid and vectors computed from them mix freely.
+type Vec2 = grid.Point
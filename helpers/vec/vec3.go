@@ -0,0 +1,129 @@
+package vec
+
+// A Vec3 is a vector in three dimensions.
+type Vec3 struct {
+	X, Y, Z int
+}
+
+// Add returns the sum of v and w.
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+}
+
+// Sub returns the difference of v and w.
+func (v Vec3) Sub(w Vec3) Vec3 {
+	return Vec3{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+}
+
+// Scale returns v multiplied by k.
+func (v Vec3) Scale(k int) Vec3 {
+	return Vec3{v.X * k, v.Y * k, v.Z * k}
+}
+
+// Neg returns the opposite of v.
+func (v Vec3) Neg() Vec3 {
+	return Vec3{-v.X, -v.Y, -v.Z}
+}
+
+// Dot returns the dot product of v and w.
+func (v Vec3) Dot(w Vec3) int {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
+}
+
+// Cross returns the cross product of v and w.
+func (v Vec3) Cross(w Vec3) Vec3 {
+	return Vec3{
+		v.Y*w.Z - v.Z*w.Y,
+		v.Z*w.X - v.X*w.Z,
+		v.X*w.Y - v.Y*w.X,
+	}
+}
+
+// Manhattan returns the Manhattan distance between v and w: the sum of the
+// absolute differences of their coordinates.
+func (v Vec3) Manhattan(w Vec3) int {
+	return abs(v.X-w.X) + abs(v.Y-w.Y) + abs(v.Z-w.Z)
+}
+
+// Chebyshev returns the Chebyshev distance between v and w: the largest
+// absolute difference of their coordinates.
+func (v Vec3) Chebyshev(w Vec3) int {
+	return max(abs(v.X-w.X), abs(v.Y-w.Y), abs(v.Z-w.Z))
+}
+
+// Rotate returns v multiplied by m.
+func (v Vec3) Rotate(m Matrix3) Vec3 {
+	return Vec3{
+		m[0][0]*v.X + m[0][1]*v.Y + m[0][2]*v.Z,
+		m[1][0]*v.X + m[1][1]*v.Y + m[1][2]*v.Z,
+		m[2][0]*v.X + m[2][1]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// A Matrix3 is a 3x3 matrix, indexed by row then column.
+type Matrix3 [3][3]int
+
+// Identity3 is the identity matrix, which leaves vectors unchanged.
+var Identity3 = Matrix3{
+	{1, 0, 0},
+	{0, 1, 0},
+	{0, 0, 1},
+}
+
+// Rotations by 90 degrees counter-clockwise around each axis.
+var (
+	RotateX = Matrix3{
+		{1, 0, 0},
+		{0, 0, -1},
+		{0, 1, 0},
+	}
+	RotateY = Matrix3{
+		{0, 0, 1},
+		{0, 1, 0},
+		{-1, 0, 0},
+	}
+	RotateZ = Matrix3{
+		{0, -1, 0},
+		{1, 0, 0},
+		{0, 0, 1},
+	}
+)
+
+// Mul returns the product of m and n. Rotating a vector by the product is the
+// same as rotating it by n, then by m.
+func (m Matrix3) Mul(n Matrix3) Matrix3 {
+	var product Matrix3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				product[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+	return product
+}
+
+// Rotations holds the 24 rotations that turn a cube onto itself, starting with
+// Identity3. They are all the ways to orient an object in three dimensions
+// using 90 degree turns.
+var Rotations = generateRotations()
+
+func generateRotations() [24]Matrix3 {
+	var rotations [24]Matrix3
+	seen := make(map[Matrix3]bool)
+
+	n := 0
+	for x, mx := 0, Identity3; x < 4; x, mx = x+1, mx.Mul(RotateX) {
+		for y, my := 0, mx; y < 4; y, my = y+1, my.Mul(RotateY) {
+			for z, mz := 0, my; z < 4; z, mz = z+1, mz.Mul(RotateZ) {
+				if !seen[mz] {
+					seen[mz] = true
+					rotations[n] = mz
+					n++
+				}
+			}
+		}
+	}
+
+	return rotations
+}
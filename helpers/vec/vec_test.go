@@ -0,0 +1,115 @@
+package vec
+
+import "testing"
+
+func TestVec3(t *testing.T) {
+	v, w := Vec3{1, -2, 3}, Vec3{4, 5, -6}
+
+	if got, want := v.Add(w), (Vec3{5, 3, -3}); got != want {
+		t.Errorf("Add: got %v, want %v", got, want)
+	}
+	if got, want := v.Sub(w), (Vec3{-3, -7, 9}); got != want {
+		t.Errorf("Sub: got %v, want %v", got, want)
+	}
+	if got, want := v.Scale(3).Neg(), (Vec3{-3, 6, -9}); got != want {
+		t.Errorf("Scale and Neg: got %v, want %v", got, want)
+	}
+	if got, want := v.Dot(w), -24; got != want {
+		t.Errorf("Dot: got %d, want %d", got, want)
+	}
+	if got, want := v.Cross(w), (Vec3{-3, 18, 13}); got != want {
+		t.Errorf("Cross: got %v, want %v", got, want)
+	}
+	if got, want := v.Manhattan(w), 19; got != want {
+		t.Errorf("Manhattan: got %d, want %d", got, want)
+	}
+	if got, want := v.Chebyshev(w), 9; got != want {
+		t.Errorf("Chebyshev: got %d, want %d", got, want)
+	}
+}
+
+func TestRotations(t *testing.T) {
+	if Rotations[0] != Identity3 {
+		t.Errorf("first rotation is %v, want the identity", Rotations[0])
+	}
+
+	if got, want := (Vec3{1, 0, 0}).Rotate(RotateZ), (Vec3{0, 1, 0}); got != want {
+		t.Errorf("rotating X around Z: got %v, want %v", got, want)
+	}
+
+	// A vector with distinct coordinates ends up in 24 different places, all
+	// at the same distance from the origin.
+	v := Vec3{1, 2, 3}
+	seen := make(map[Vec3]bool)
+	for _, r := range Rotations {
+		w := v.Rotate(r)
+		if w.Manhattan(Vec3{}) != 6 {
+			t.Errorf("rotation %v moved %v to %v", r, v, w)
+		}
+		seen[w] = true
+
+		// Rotations keep handedness, unlike mirroring.
+		x, y := Vec3{1, 0, 0}.Rotate(r), Vec3{0, 1, 0}.Rotate(r)
+		if got, want := x.Cross(y), (Vec3{0, 0, 1}).Rotate(r); got != want {
+			t.Errorf("rotation %v is a reflection", r)
+		}
+	}
+	if len(seen) != 24 {
+		t.Errorf("got %d orientations, want 24", len(seen))
+	}
+}
+
+type vec4 = VecN[int, [4]int]
+
+func TestVecN(t *testing.T) {
+	v, w := vec4{[4]int{1, -2, 3, 4}}, vec4{[4]int{0, 2, 3, -1}}
+
+	testCases := []struct {
+		name string
+		got  vec4
+		want vec4
+	}{
+		{"add", v.Add(w), vec4{[4]int{1, 0, 6, 3}}},
+		{"sub", v.Sub(w), vec4{[4]int{1, -4, 0, 5}}},
+		{"scale", v.Scale(2), vec4{[4]int{2, -4, 6, 8}}},
+	}
+	for _, tc := range testCases {
+		if tc.got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.got, tc.want)
+		}
+	}
+	if v != (vec4{[4]int{1, -2, 3, 4}}) {
+		t.Errorf("operations changed their receiver: %v", v)
+	}
+
+	if got, want := v.Dot(w), 1; got != want {
+		t.Errorf("Dot: got %d, want %d", got, want)
+	}
+	if got, want := v.Manhattan(w), 10; got != want {
+		t.Errorf("Manhattan: got %d, want %d", got, want)
+	}
+	if got, want := v.Chebyshev(w), 5; got != want {
+		t.Errorf("Chebyshev: got %d, want %d", got, want)
+	}
+
+	seen := map[vec4]bool{v: true}
+	if !seen[v.Add(w).Sub(w)] {
+		t.Errorf("equal vectors are different map keys")
+	}
+
+	f := Convert[float64, [4]float64](v).Scale(0.5)
+	if got, want := f, (VecN[float64, [4]float64]{[4]float64{0.5, -1, 1.5, 2}}); got != want {
+		t.Errorf("Convert: got %v, want %v", got, want)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { v = v.Add(w).Scale(2) }); allocs != 0 {
+		t.Errorf("operations allocate %v times", allocs)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("converting to a different dimension did not panic")
+		}
+	}()
+	Convert[int, [3]int](v)
+}
@@ -0,0 +1,98 @@
+package vec
+
+// Number is the set of types VecN can hold.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Array is the set of arrays VecN stores its coordinates in, from two to eight
+// dimensions.
+type Array[T Number] interface {
+	~[2]T | ~[3]T | ~[4]T | ~[5]T | ~[6]T | ~[7]T | ~[8]T
+}
+
+// A VecN is a vector of any dimension, with coordinates of type T stored in an
+// array of type A. Like the array, it is comparable, so it can be used as a map
+// key, and its methods do not allocate. Declaring a type for the dimension a
+// puzzle needs keeps its code short:
+//
+//	type Vec4 = vec.VecN[int, [4]int]
+//
+//	v := Vec4{C: [4]int{1, 2, 3, 4}}
+type VecN[T Number, A Array[T]] struct {
+	C A
+}
+
+// Add returns the sum of v and w.
+func (v VecN[T, A]) Add(w VecN[T, A]) VecN[T, A] {
+	for i := range len(v.C) {
+		v.C[i] += w.C[i]
+	}
+	return v
+}
+
+// Sub returns the difference of v and w.
+func (v VecN[T, A]) Sub(w VecN[T, A]) VecN[T, A] {
+	for i := range len(v.C) {
+		v.C[i] -= w.C[i]
+	}
+	return v
+}
+
+// Scale returns v multiplied by k.
+func (v VecN[T, A]) Scale(k T) VecN[T, A] {
+	for i := range len(v.C) {
+		v.C[i] *= k
+	}
+	return v
+}
+
+// Dot returns the dot product of v and w.
+func (v VecN[T, A]) Dot(w VecN[T, A]) T {
+	var dot T
+	for i := range len(v.C) {
+		dot += v.C[i] * w.C[i]
+	}
+	return dot
+}
+
+// Manhattan returns the Manhattan distance between v and w: the sum of the
+// absolute differences of their coordinates.
+func (v VecN[T, A]) Manhattan(w VecN[T, A]) T {
+	var dist T
+	for i := range len(v.C) {
+		dist += abs(v.C[i] - w.C[i])
+	}
+	return dist
+}
+
+// Chebyshev returns the Chebyshev distance between v and w: the largest
+// absolute difference of their coordinates.
+func (v VecN[T, A]) Chebyshev(w VecN[T, A]) T {
+	var dist T
+	for i := range len(v.C) {
+		dist = max(dist, abs(v.C[i]-w.C[i]))
+	}
+	return dist
+}
+
+// Convert returns v with coordinates converted to type U and stored in an
+// array of type B, for example to switch from integers to floating-point
+// numbers. It panics if A and B have different lengths.
+func Convert[U Number, B Array[U], T Number, A Array[T]](v VecN[T, A]) VecN[U, B] {
+	var converted VecN[U, B]
+	if len(converted.C) != len(v.C) {
+		panic("vec: vectors have different dimensions")
+	}
+	for i := range len(v.C) {
+		converted.C[i] = U(v.C[i])
+	}
+	return converted
+}
+
+func abs[T Number](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,341 @@
+// Package leaderboard reads and renders private leaderboards of the Advent of
+// Code website.
+package leaderboard
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+// RefreshInterval is how long a downloaded leaderboard is reused before being
+// downloaded again. The website asks that private leaderboards be requested at
+// most once every 15 minutes.
+const RefreshInterval = 15 * time.Minute
+
+// SavedFile returns the path where Load saves a leaderboard, relative to the
+// working directory.
+func SavedFile(year, id int) string {
+	return filepath.Join(".adventofcode", "leaderboards", fmt.Sprintf("%d-%d.json", year, id))
+}
+
+// A Leaderboard is a private leaderboard of a year's Advent of Code.
+type Leaderboard struct {
+	Year int `json:"year"`
+
+	// ID of the member who owns the leaderboard, which is also the ID of the
+	// leaderboard.
+	OwnerID int `json:"owner_id"`
+
+	// Members, sorted by ID until sorted otherwise with Sort.
+	Members []Member `json:"members"`
+}
+
+// A Member is a user on a private leaderboard.
+type Member struct {
+	ID int `json:"id"`
+
+	// Name of the user, or empty if the user is anonymous.
+	Name string `json:"name,omitempty"`
+
+	Stars       int `json:"stars"`
+	LocalScore  int `json:"local_score"`
+	GlobalScore int `json:"global_score"`
+
+	// When the user got their latest star, or the zero time if they have none.
+	LastStar time.Time `json:"last_star,omitempty"`
+
+	// Stars the user got, sorted by day and part.
+	Completions []Star `json:"completions"`
+}
+
+// A Star is a part of a puzzle solved by a member.
+type Star struct {
+	Day  int `json:"day"`
+	Part int `json:"part"`
+
+	// When the member solved the part.
+	Time time.Time `json:"time"`
+}
+
+// DisplayName returns the name of m, as the website shows it.
+func (m Member) DisplayName() string {
+	if m.Name == "" {
+		return fmt.Sprintf("(anonymous user #%d)", m.ID)
+	}
+	return m.Name
+}
+
+// Star returns when m solved the given part of a puzzle. It returns false if m
+// has not solved it.
+func (m Member) Star(day, part int) (time.Time, bool) {
+	for _, s := range m.Completions {
+		if s.Day == day && s.Part == part {
+			return s.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// rawLeaderboard is the JSON representation of a leaderboard, as served by the
+// website. Over the years, the website has served numbers both as JSON
+// numbers and as strings.
+type rawLeaderboard struct {
+	Event   flexInt              `json:"event"`
+	OwnerID flexInt              `json:"owner_id"`
+	Members map[string]rawMember `json:"members"`
+}
+
+type rawMember struct {
+	ID          flexInt `json:"id"`
+	Name        *string `json:"name"`
+	Stars       flexInt `json:"stars"`
+	LocalScore  flexInt `json:"local_score"`
+	GlobalScore flexInt `json:"global_score"`
+	LastStarTS  flexInt `json:"last_star_ts"`
+
+	// Maps days to parts to completions.
+	CompletionDayLevel map[string]map[string]struct {
+		GetStarTS flexInt `json:"get_star_ts"`
+	} `json:"completion_day_level"`
+}
+
+// flexInt is an integer encoded in JSON either as a number or as a string.
+type flexInt int64
+
+func (n *flexInt) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s", data)
+	}
+	*n = flexInt(v)
+
+	return nil
+}
+
+// unixTime converts a timestamp of the website to a time, or the zero time if
+// there is no timestamp.
+func unixTime(ts flexInt) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(ts), 0).UTC()
+}
+
+// Parse parses a leaderboard from its JSON representation, as served by the
+// website.
+func Parse(data []byte) (*Leaderboard, error) {
+	// The website responds with a page instead of JSON when the user cannot
+	// see the leaderboard.
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '<' {
+		return nil, errors.New("got a web page instead of JSON; is the session cookie valid, and are you a member of the leaderboard?")
+	}
+
+	var raw rawLeaderboard
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("decoding leaderboard: %w", err)
+	}
+
+	lb := &Leaderboard{
+		Year:    int(raw.Event),
+		OwnerID: int(raw.OwnerID),
+		Members: make([]Member, 0, len(raw.Members)),
+	}
+
+	for key, rm := range raw.Members {
+		m := Member{
+			ID:          int(rm.ID),
+			Stars:       int(rm.Stars),
+			LocalScore:  int(rm.LocalScore),
+			GlobalScore: int(rm.GlobalScore),
+			LastStar:    unixTime(rm.LastStarTS),
+		}
+		if m.ID == 0 {
+			m.ID, _ = strconv.Atoi(key)
+		}
+		if rm.Name != nil {
+			m.Name = *rm.Name
+		}
+
+		for dayKey, levels := range rm.CompletionDayLevel {
+			day, err := strconv.Atoi(dayKey)
+			if err != nil {
+				return nil, fmt.Errorf("member %d: invalid day %q", m.ID, dayKey)
+			}
+			for partKey, level := range levels {
+				part, err := strconv.Atoi(partKey)
+				if err != nil {
+					return nil, fmt.Errorf("member %d: invalid part %q of day %d", m.ID, partKey, day)
+				}
+				m.Completions = append(m.Completions, Star{Day: day, Part: part, Time: unixTime(level.GetStarTS)})
+			}
+		}
+
+		sort.Slice(m.Completions, func(i, j int) bool {
+			a, b := m.Completions[i], m.Completions[j]
+			if a.Day != b.Day {
+				return a.Day < b.Day
+			}
+			return a.Part < b.Part
+		})
+
+		lb.Members = append(lb.Members, m)
+	}
+
+	sort.Slice(lb.Members, func(i, j int) bool { return lb.Members[i].ID < lb.Members[j].ID })
+
+	return lb, nil
+}
+
+// ReadFile parses the leaderboard saved at path.
+func ReadFile(path string) (*Leaderboard, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	lb, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return lb, nil
+}
+
+// Load returns the leaderboard with the given ID. If the leaderboard was saved
+// to path less than RefreshInterval before now, Load reads it from there.
+// Otherwise, it downloads the leaderboard with client and saves it to path.
+// Load also returns when the leaderboard was downloaded.
+func Load(client *aoc.Client, path string, year, id int, now time.Time) (*Leaderboard, time.Time, error) {
+	info, err := os.Stat(path)
+	if err == nil && now.Sub(info.ModTime()) < RefreshInterval {
+		lb, err := ReadFile(path)
+		if err == nil && lb.Year == year {
+			return lb, info.ModTime(), nil
+		}
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, time.Time{}, fmt.Errorf("checking %q: %w", path, err)
+	}
+
+	data, err := client.DownloadLeaderboard(year, id)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("downloading leaderboard: %w", err)
+	}
+
+	lb, err := Parse(data)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, time.Time{}, fmt.Errorf("creating directory %q: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return nil, time.Time{}, fmt.Errorf("writing %q: %w", path, err)
+	}
+	if err := os.Chtimes(path, now, now); err != nil {
+		return nil, time.Time{}, fmt.Errorf("setting modification time of %q: %w", path, err)
+	}
+
+	return lb, now, nil
+}
+
+// Orders members can be sorted in.
+const (
+	// Highest local score first, like the website.
+	ByScore = "score"
+	// Most stars first.
+	ByStars = "stars"
+	// Most recent star first.
+	ByLastStar = "last-star"
+	// Alphabetically.
+	ByName = "name"
+	// Fastest to solve a given day first.
+	ByTime = "time"
+)
+
+// Sort sorts the members of lb in the given order. Sorting by time requires a
+// day; members are sorted by when they solved its second part, then its first.
+func (lb *Leaderboard) Sort(by string, day int) error {
+	var less func(a, b Member) bool
+
+	switch by {
+	case ByScore:
+		less = func(a, b Member) bool {
+			if a.LocalScore != b.LocalScore {
+				return a.LocalScore > b.LocalScore
+			}
+			return earlier(a.LastStar, b.LastStar)
+		}
+	case ByStars:
+		less = func(a, b Member) bool {
+			if a.Stars != b.Stars {
+				return a.Stars > b.Stars
+			}
+			return earlier(a.LastStar, b.LastStar)
+		}
+	case ByLastStar:
+		less = func(a, b Member) bool {
+			return a.LastStar.After(b.LastStar)
+		}
+	case ByName:
+		less = func(a, b Member) bool {
+			return strings.ToLower(a.DisplayName()) < strings.ToLower(b.DisplayName())
+		}
+	case ByTime:
+		if day == 0 {
+			return errors.New("sorting by time requires a day")
+		}
+		less = func(a, b Member) bool {
+			for _, part := range []int{2, 1} {
+				ta, _ := a.Star(day, part)
+				tb, _ := b.Star(day, part)
+				if !ta.Equal(tb) {
+					return earlier(ta, tb)
+				}
+			}
+			return false
+		}
+	default:
+		return fmt.Errorf("unknown order %q", by)
+	}
+
+	sort.SliceStable(lb.Members, func(i, j int) bool {
+		return less(lb.Members[i], lb.Members[j])
+	})
+
+	return nil
+}
+
+// earlier reports whether a is before b, where the zero time comes after all
+// others.
+func earlier(a, b time.Time) bool {
+	switch {
+	case a.IsZero():
+		return false
+	case b.IsZero():
+		return true
+	default:
+		return a.Before(b)
+	}
+}
@@ -0,0 +1,242 @@
+package leaderboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]*Leaderboard{
+		"2023.json": {
+			Year:    2023,
+			OwnerID: 123456,
+			Members: []Member{
+				{
+					ID:         123456,
+					Name:       "Alice",
+					Stars:      5,
+					LocalScore: 28,
+					LastStar:   time.Unix(1701580000, 0).UTC(),
+					Completions: []Star{
+						{Day: 1, Part: 1, Time: time.Unix(1701407543, 0).UTC()},
+						{Day: 1, Part: 2, Time: time.Unix(1701408203, 0).UTC()},
+						{Day: 2, Part: 1, Time: time.Unix(1701493500, 0).UTC()},
+						{Day: 2, Part: 2, Time: time.Unix(1701494100, 0).UTC()},
+						{Day: 3, Part: 1, Time: time.Unix(1701580000, 0).UTC()},
+					},
+				},
+				{
+					ID:         234567,
+					Stars:      2,
+					LocalScore: 10,
+					LastStar:   time.Unix(1701500000, 0).UTC(),
+					Completions: []Star{
+						{Day: 1, Part: 1, Time: time.Unix(1701410000, 0).UTC()},
+						{Day: 1, Part: 2, Time: time.Unix(1701500000, 0).UTC()},
+					},
+				},
+				{
+					ID:   345678,
+					Name: "Bob",
+				},
+			},
+		},
+		// Older leaderboards encode numbers as strings.
+		"2018.json": {
+			Year:    2018,
+			OwnerID: 42,
+			Members: []Member{
+				{
+					ID:         42,
+					Name:       "Carol",
+					Stars:      1,
+					LocalScore: 3,
+					LastStar:   time.Date(2018, time.December, 1, 5, 10, 0, 0, time.UTC),
+					Completions: []Star{
+						{Day: 1, Part: 1, Time: time.Date(2018, time.December, 1, 5, 10, 0, 0, time.UTC)},
+					},
+				},
+				{
+					ID: 43,
+				},
+			},
+		},
+	}
+
+	for file, want := range testCases {
+		t.Run(file, func(t *testing.T) {
+			got, err := ReadFile(filepath.Join("testdata", file))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("leaderboard mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := map[string]string{
+		"not-member.html":    "got a web page instead of JSON",
+		"bad-day.json":       `member 1: invalid day "first"`,
+		"bad-timestamp.json": `invalid integer "yesterday"`,
+		"missing.json":       "no such file or directory",
+	}
+
+	for file, want := range testCases {
+		t.Run(file, func(t *testing.T) {
+			_, err := ReadFile(filepath.Join("testdata", file))
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("got error %v, want an error containing %q", err, want)
+			}
+		})
+	}
+
+	if _, err := Parse([]byte(`{"event": "2023", "members": `)); err == nil {
+		t.Errorf("expected an error for truncated JSON")
+	}
+}
+
+func TestMember(t *testing.T) {
+	lb, err := ReadFile(filepath.Join("testdata", "2023.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice, anonymous := lb.Members[0], lb.Members[1]
+
+	if got := alice.DisplayName(); got != "Alice" {
+		t.Errorf("got name %q, want %q", got, "Alice")
+	}
+	if got, want := anonymous.DisplayName(), "(anonymous user #234567)"; got != want {
+		t.Errorf("got name %q, want %q", got, want)
+	}
+
+	if got, ok := alice.Star(2, 2); !ok || !got.Equal(time.Unix(1701494100, 0)) {
+		t.Errorf("got star %v, %t for day 2 part 2", got, ok)
+	}
+	if _, ok := alice.Star(3, 2); ok {
+		t.Errorf("got star for unsolved day 3 part 2")
+	}
+}
+
+func TestSort(t *testing.T) {
+	testCases := []struct {
+		by   string
+		day  int
+		want []int
+	}{
+		{ByScore, 0, []int{123456, 234567, 345678}},
+		{ByStars, 0, []int{123456, 234567, 345678}},
+		{ByLastStar, 0, []int{123456, 234567, 345678}},
+		{ByName, 0, []int{234567, 123456, 345678}},
+		{ByTime, 1, []int{123456, 234567, 345678}},
+		// Only Alice solved day 3; others keep their order.
+		{ByTime, 3, []int{123456, 345678, 234567}},
+	}
+
+	for _, tc := range testCases {
+		lb, err := ReadFile(filepath.Join("testdata", "2023.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Start from the reverse of the expected order for most cases.
+		for i, j := 0, len(lb.Members)-1; i < j; i, j = i+1, j-1 {
+			lb.Members[i], lb.Members[j] = lb.Members[j], lb.Members[i]
+		}
+
+		if err := lb.Sort(tc.by, tc.day); err != nil {
+			t.Fatalf("sorting by %s: unexpected error: %v", tc.by, err)
+		}
+
+		var got []int
+		for _, m := range lb.Members {
+			got = append(got, m.ID)
+		}
+		if diff := cmp.Diff(tc.want, got); diff != "" {
+			t.Errorf("sorting by %s, day %d: mismatch (-want +got):\n%s", tc.by, tc.day, diff)
+		}
+	}
+
+	lb := &Leaderboard{}
+	if err := lb.Sort(ByTime, 0); err == nil {
+		t.Errorf("expected an error when sorting by time without a day")
+	}
+	if err := lb.Sort("age", 0); err == nil {
+		t.Errorf("expected an error for an unknown order")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "2023.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	response := fixture
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2023/leaderboard/private/view/123456.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(response)
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	path := filepath.Join(t.TempDir(), SavedFile(2023, 123456))
+	now := time.Date(2023, time.December, 3, 12, 0, 0, 0, time.UTC)
+
+	load := func(at time.Time) {
+		t.Helper()
+		lb, fetched, err := Load(client, path, 2023, 123456, at)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(lb.Members) != 3 {
+			t.Errorf("got %d members, want 3", len(lb.Members))
+		}
+		if !fetched.Equal(now) {
+			t.Errorf("got leaderboard fetched at %v, want %v", fetched, now)
+		}
+	}
+
+	load(now)
+	if requests != 1 {
+		t.Fatalf("got %d requests, want 1", requests)
+	}
+
+	// The saved leaderboard is reused for a while.
+	load(now.Add(10 * time.Minute))
+	if requests != 1 {
+		t.Errorf("got %d requests, want the saved leaderboard to be reused", requests)
+	}
+
+	now = now.Add(RefreshInterval)
+	load(now)
+	if requests != 2 {
+		t.Errorf("got %d requests, want the leaderboard to be downloaded again", requests)
+	}
+
+	// Pages served instead of the leaderboard are not saved.
+	response = []byte("<html></html>")
+	if _, _, err := Load(client, path, 2023, 123456, now.Add(RefreshInterval)); err == nil {
+		t.Errorf("expected an error for a web page")
+	}
+	if saved, _ := os.ReadFile(path); string(saved) != string(fixture) {
+		t.Errorf("saved leaderboard was overwritten")
+	}
+}
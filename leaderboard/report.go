@@ -0,0 +1,112 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+// Formats supported by WriteLeaderboard.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Symbols used in the grid of stars rendered by WriteLeaderboard.
+const (
+	symbolBoth  = "★"
+	symbolFirst = "☆"
+	symbolNone  = "·"
+)
+
+// WriteLeaderboard writes lb to w in the given format, with members in their
+// current order. The text format shows the stars of each member for every day.
+// If day is not zero, it also shows when each member solved the puzzle of that
+// day, relative to when the puzzle unlocked.
+func WriteLeaderboard(w io.Writer, lb *Leaderboard, format string, day int) error {
+	switch format {
+	case FormatText:
+		return writeText(w, lb, day)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(lb)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeText(w io.Writer, lb *Leaderboard, day int) error {
+	days := aoc.DaysInYear(lb.Year)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "🏆 Leaderboard %d of Advent of Code %d\n\n", lb.OwnerID, lb.Year)
+
+	// Days are numbered vertically, like on the website.
+	prefix := strings.Repeat(" ", 10)
+	b.WriteString(prefix)
+	for d := 1; d <= days; d++ {
+		if d >= 10 {
+			fmt.Fprintf(&b, "%d", d/10)
+		} else {
+			b.WriteString(" ")
+		}
+	}
+	b.WriteString("\n" + prefix)
+	for d := 1; d <= days; d++ {
+		fmt.Fprintf(&b, "%d", d%10)
+	}
+	b.WriteString("\n")
+
+	for i, m := range lb.Members {
+		fmt.Fprintf(&b, "%3d) %4d ", i+1, m.LocalScore)
+		for d := 1; d <= days; d++ {
+			_, first := m.Star(d, 1)
+			_, second := m.Star(d, 2)
+			switch {
+			case second:
+				b.WriteString(symbolBoth)
+			case first:
+				b.WriteString(symbolFirst)
+			default:
+				b.WriteString(symbolNone)
+			}
+		}
+		fmt.Fprintf(&b, "  %2d★  %s\n", m.Stars, m.DisplayName())
+	}
+
+	fmt.Fprintf(&b, "\nLegend: %s both parts  %s first part  %s not solved\n", symbolBoth, symbolFirst, symbolNone)
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return err
+	}
+
+	if day == 0 {
+		return nil
+	}
+
+	unlock := aoc.UnlockTime(lb.Year, day)
+	fmt.Fprintf(w, "\n📅 Day %d, time since unlock\n\n", day)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, "\tPart 1\tPart 2\tName\n")
+	for i, m := range lb.Members {
+		fmt.Fprintf(tw, "%3d)", i+1)
+		for part := 1; part <= 2; part++ {
+			fmt.Fprint(tw, "\t")
+			if t, ok := m.Star(day, part); ok {
+				fmt.Fprint(tw, aoc.FormatClock(t.Sub(unlock)))
+			} else {
+				fmt.Fprint(tw, "-")
+			}
+		}
+		fmt.Fprintf(tw, "\t%s\n", m.DisplayName())
+	}
+
+	return tw.Flush()
+}
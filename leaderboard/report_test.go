@@ -0,0 +1,50 @@
+package leaderboard
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteLeaderboard(t *testing.T) {
+	lb, err := ReadFile(filepath.Join("testdata", "2023.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lb.Sort(ByScore, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := WriteLeaderboard(&b, lb, FormatText, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `🏆 Leaderboard 123456 of Advent of Code 2023
+
+                   1111111111222222
+          1234567890123456789012345
+  1)   28 ★★☆······················   5★  Alice
+  2)   10 ★························   2★  (anonymous user #234567)
+  3)    0 ·························   0★  Bob
+
+Legend: ★ both parts  ☆ first part  · not solved
+
+📅 Day 1, time since unlock
+
+      Part 1    Part 2       Name
+  1)  00:12:23  00:23:23     Alice
+  2)  00:53:20  1d 01:53:20  (anonymous user #234567)
+  3)  -         -            Bob
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+
+	b.Reset()
+	if err := WriteLeaderboard(&b, lb, "xml", 0); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,150 @@
+// Package ledger keeps track of answers submitted to the Advent of Code
+// website, so that known wrong answers are never submitted twice.
+package ledger
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+// DefaultFile is the path of the ledger, relative to the working directory.
+var DefaultFile = filepath.Join(".adventofcode", "answers.json")
+
+// ProfileFile returns the path of the ledger of the given profile, relative to
+// the working directory. Without a profile, it is DefaultFile.
+func ProfileFile(profile string) string {
+	if profile == "" {
+		return DefaultFile
+	}
+	return filepath.Join(".adventofcode", "profiles", profile, "answers.json")
+}
+
+// An Attempt is an answer submitted to the website, and its verdict.
+type Attempt struct {
+	Year    int         `json:"year"`
+	Day     int         `json:"day"`
+	Part    int         `json:"part"`
+	Answer  string      `json:"answer"`
+	Verdict aoc.Verdict `json:"verdict"`
+	Time    time.Time   `json:"time"`
+}
+
+// judged reports whether the website evaluated the attempt's answer.
+func (a Attempt) judged() bool {
+	return a.Verdict != aoc.RateLimited && a.Verdict != aoc.WrongLevel
+}
+
+// A Ledger is the list of all attempts made so far, stored in a file.
+type Ledger struct {
+	path     string
+	Attempts []Attempt
+}
+
+// Load reads the ledger stored at path. If the file does not exist, Load
+// returns an empty ledger.
+func Load(path string) (*Ledger, error) {
+	l := &Ledger{path: path}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading ledger %q: %w", path, err)
+	}
+
+	if err := json.Unmarshal(content, &l.Attempts); err != nil {
+		return nil, fmt.Errorf("decoding ledger %q: %w", path, err)
+	}
+
+	return l, nil
+}
+
+// Save writes the ledger to the file it was loaded from.
+func (l *Ledger) Save() error {
+	content, err := json.MarshalIndent(l.Attempts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding ledger: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %w", filepath.Dir(l.path), err)
+	}
+
+	if err := os.WriteFile(l.path, append(content, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing ledger %q: %w", l.path, err)
+	}
+
+	return nil
+}
+
+// Add records a new attempt.
+func (l *Ledger) Add(a Attempt) {
+	l.Attempts = append(l.Attempts, a)
+}
+
+// Find returns all attempts for the given part of a puzzle, oldest first.
+func (l *Ledger) Find(year, day, part int) []Attempt {
+	var found []Attempt
+	for _, a := range l.Attempts {
+		if a.Year == year && a.Day == day && a.Part == part {
+			found = append(found, a)
+		}
+	}
+	return found
+}
+
+// CorrectAnswer returns the answer accepted for the given part of a puzzle, if
+// any.
+func (l *Ledger) CorrectAnswer(year, day, part int) (string, bool) {
+	for _, a := range l.Find(year, day, part) {
+		if a.Verdict == aoc.Correct {
+			return a.Answer, true
+		}
+	}
+	return "", false
+}
+
+// Check returns an error if previous attempts show that answer is wrong, or if
+// the puzzle part is already solved. Numeric answers are also checked against
+// the bounds given by previous "too high" and "too low" verdicts.
+func (l *Ledger) Check(year, day, part int, answer string) error {
+	if correct, ok := l.CorrectAnswer(year, day, part); ok {
+		if correct == answer {
+			return fmt.Errorf("answer %q was already accepted", answer)
+		}
+		return fmt.Errorf("part already solved with answer %q", correct)
+	}
+
+	value, numErr := strconv.ParseInt(answer, 10, 64)
+
+	for _, a := range l.Find(year, day, part) {
+		if !a.judged() {
+			continue
+		}
+
+		if a.Answer == answer {
+			return fmt.Errorf("answer %q was already rejected on %s", answer, a.Time.Format(time.RFC1123))
+		}
+
+		previous, err := strconv.ParseInt(a.Answer, 10, 64)
+		if numErr != nil || err != nil {
+			continue
+		}
+
+		if a.Verdict == aoc.TooHigh && value >= previous {
+			return fmt.Errorf("answer %q is not lower than %q, which was too high", answer, a.Answer)
+		}
+		if a.Verdict == aoc.TooLow && value <= previous {
+			return fmt.Errorf("answer %q is not higher than %q, which was too low", answer, a.Answer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+func TestLedgerCheck(t *testing.T) {
+	l := &Ledger{}
+	l.Add(Attempt{Year: 2024, Day: 1, Part: 1, Answer: "100", Verdict: aoc.TooHigh})
+	l.Add(Attempt{Year: 2024, Day: 1, Part: 1, Answer: "10", Verdict: aoc.TooLow})
+	l.Add(Attempt{Year: 2024, Day: 1, Part: 1, Answer: "50", Verdict: aoc.RateLimited})
+	l.Add(Attempt{Year: 2024, Day: 1, Part: 1, Answer: "42", Verdict: aoc.Incorrect})
+	l.Add(Attempt{Year: 2024, Day: 2, Part: 1, Answer: "abc", Verdict: aoc.Correct})
+
+	testCases := []struct {
+		name      string
+		day       int
+		answer    string
+		wantError bool
+	}{
+		{name: "within_bounds", day: 1, answer: "50", wantError: false},
+		{name: "known_wrong", day: 1, answer: "42", wantError: true},
+		{name: "too_high", day: 1, answer: "150", wantError: true},
+		{name: "too_low", day: 1, answer: "5", wantError: true},
+		{name: "equal_to_too_high", day: 1, answer: "100", wantError: true},
+		{name: "not_a_number", day: 1, answer: "xyz", wantError: false},
+		{name: "already_accepted", day: 2, answer: "abc", wantError: true},
+		{name: "already_solved", day: 2, answer: "def", wantError: true},
+		{name: "no_attempts", day: 3, answer: "1", wantError: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := l.Check(2024, tc.day, 1, tc.answer)
+			if tc.wantError && err == nil {
+				t.Errorf("expected an error")
+			}
+			if !tc.wantError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestLedgerSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "answers.json")
+
+	l, err := Load(path)
+	if err != nil {
+		t.Fatalf("could not load missing ledger: %v", err)
+	}
+	if len(l.Attempts) != 0 {
+		t.Fatalf("expected empty ledger, got %d attempts", len(l.Attempts))
+	}
+
+	attempt := Attempt{
+		Year:    2024,
+		Day:     17,
+		Part:    2,
+		Answer:  "265652340990875",
+		Verdict: aoc.Correct,
+		Time:    time.Date(2024, time.December, 17, 5, 42, 0, 0, time.UTC),
+	}
+	l.Add(attempt)
+
+	if err := l.Save(); err != nil {
+		t.Fatalf("could not save ledger: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("could not load ledger: %v", err)
+	}
+	if len(loaded.Attempts) != 1 || loaded.Attempts[0] != attempt {
+		t.Fatalf("got attempts %+v, want [%+v]", loaded.Attempts, attempt)
+	}
+
+	if answer, ok := loaded.CorrectAnswer(2024, 17, 2); !ok || answer != attempt.Answer {
+		t.Errorf("got correct answer %q, want %q", answer, attempt.Answer)
+	}
+}
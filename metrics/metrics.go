@@ -0,0 +1,320 @@
+// Package metrics measures the code of the solutions stored in an Advent of
+// Code working directory, to find which puzzles took the most code and which
+// helpers are reused the most.
+package metrics
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/busser/adventofcode/benchmark"
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+// helpersPath is the import path of the helpers package, relative to the
+// module's path.
+const helpersPath = "/helpers"
+
+// A Package holds metrics of the package solving a puzzle. Only code outside
+// of tests is measured.
+type Package struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+
+	// Path of the package, relative to the working directory.
+	Dir string `json:"dir"`
+
+	// Lines holding code, excluding blank lines and comments.
+	Lines int `json:"lines"`
+
+	// Declared types, and functions including methods.
+	Types int `json:"types"`
+	Funcs int `json:"funcs"`
+
+	Parts []Part `json:"parts"`
+
+	// Exported identifiers of the helpers package used, sorted by name.
+	Helpers []string `json:"helpers"`
+}
+
+// A Part holds metrics of the function solving one part of a puzzle.
+type Part struct {
+	Part int `json:"part"`
+
+	// Cyclomatic complexity of the function, not including the functions it
+	// calls.
+	Complexity int `json:"complexity"`
+
+	// Median duration of the part's latest benchmark, or zero if unknown.
+	Median time.Duration `json:"median_ns,omitempty"`
+}
+
+// Complexity returns the sum of the cyclomatic complexities of p's parts.
+func (p Package) Complexity() int {
+	sum := 0
+	for _, part := range p.Parts {
+		sum += part.Complexity
+	}
+	return sum
+}
+
+// Median returns the sum of the median durations of p's parts, or zero if
+// any is unknown.
+func (p Package) Median() time.Duration {
+	var sum time.Duration
+	for _, part := range p.Parts {
+		if part.Median == 0 {
+			return 0
+		}
+		sum += part.Median
+	}
+	return sum
+}
+
+// Measure measures every package of every year found in workdir, sorted by
+// year and day.
+func Measure(workdir string) ([]Package, error) {
+	modulePath, err := scaffolding.ModulePath(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	dirs, err := scaffolding.SolutionDirs(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+
+	for _, dir := range dirs {
+		p := Package{Year: dir.Year, Day: dir.Day, Dir: dir.Dir}
+
+		if err := measurePackage(workdir, modulePath+helpersPath, &p); err != nil {
+			return nil, err
+		}
+		if p.Lines > 0 {
+			packages = append(packages, p)
+		}
+	}
+
+	return packages, nil
+}
+
+// measurePackage measures the Go files of p's directory, except tests.
+func measurePackage(workdir, helpersImport string, p *Package) error {
+	dir := filepath.Join(workdir, p.Dir)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("listing directory %q: %w", p.Dir, err)
+	}
+
+	fset := token.NewFileSet()
+	helpers := make(map[string]bool)
+	complexity := make(map[int]int)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", name, err)
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(p.Dir, name), src, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %w", filepath.Join(p.Dir, name), err)
+		}
+
+		p.Lines += countLines(src)
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				p.Funcs++
+				if decl.Recv != nil {
+					continue
+				}
+				for part := 1; scaffolding.PartFuncName(part) != ""; part++ {
+					if decl.Name.Name == scaffolding.PartFuncName(part) {
+						complexity[part] = Cyclomatic(decl)
+					}
+				}
+			case *ast.GenDecl:
+				if decl.Tok == token.TYPE {
+					p.Types += len(decl.Specs)
+				}
+			}
+		}
+
+		for name := range usedIdentifiers(file, helpersImport) {
+			helpers[name] = true
+		}
+	}
+
+	for part := 1; scaffolding.PartFuncName(part) != ""; part++ {
+		if c, ok := complexity[part]; ok {
+			p.Parts = append(p.Parts, Part{Part: part, Complexity: c})
+		}
+	}
+
+	p.Helpers = make([]string, 0, len(helpers))
+	for name := range helpers {
+		p.Helpers = append(p.Helpers, name)
+	}
+	sort.Strings(p.Helpers)
+
+	return nil
+}
+
+// countLines returns the number of lines of src holding at least one token
+// other than a comment.
+func countLines(src []byte) int {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", -1, len(src))
+
+	var s scanner.Scanner
+	s.Init(file, src, nil, 0)
+
+	lines := make(map[int]bool)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		// Semicolons inserted automatically at the end of lines do not count.
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		lines[file.Line(pos)] = true
+	}
+
+	return len(lines)
+}
+
+// usedIdentifiers returns the identifiers of the package imported with the
+// given path that file uses.
+func usedIdentifiers(file *ast.File, path string) map[string]bool {
+	var name string
+	for _, spec := range file.Imports {
+		if p, _ := strconv.Unquote(spec.Path.Value); p != path {
+			continue
+		}
+		name = filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+	}
+	if name == "" || name == "_" {
+		return nil
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := sel.X.(*ast.Ident); ok && x.Name == name {
+			used[sel.Sel.Name] = true
+		}
+		return true
+	})
+
+	return used
+}
+
+// Cyclomatic returns the cyclomatic complexity of fn: one, plus one for each
+// branch of its control flow, including those of function literals it
+// declares.
+func Cyclomatic(fn *ast.FuncDecl) int {
+	complexity := 1
+
+	ast.Inspect(fn, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if n.List != nil {
+				complexity++
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+
+	return complexity
+}
+
+// AddBenchmarks sets the median duration of every part of packages that was
+// benchmarked in history, oldest record first. Each part gets the duration of
+// the latest record that benchmarked it.
+func AddBenchmarks(packages []Package, history []benchmark.Record) {
+	type key struct{ year, day, part int }
+	medians := make(map[key]time.Duration)
+	for _, record := range history {
+		for _, r := range record.Results {
+			if r.Err == "" {
+				medians[key{r.Year, r.Day, r.Part}] = r.Median
+			}
+		}
+	}
+
+	for i := range packages {
+		p := &packages[i]
+		for j := range p.Parts {
+			p.Parts[j].Median = medians[key{p.Year, p.Day, p.Parts[j].Part}]
+		}
+	}
+}
+
+// A HelperUsage counts the packages using an identifier of the helpers
+// package.
+type HelperUsage struct {
+	Name     string `json:"name"`
+	Packages int    `json:"packages"`
+}
+
+// Helpers returns how many of packages use each identifier of the helpers
+// package, most used first.
+func Helpers(packages []Package) []HelperUsage {
+	counts := make(map[string]int)
+	for _, p := range packages {
+		for _, name := range p.Helpers {
+			counts[name]++
+		}
+	}
+
+	usage := make([]HelperUsage, 0, len(counts))
+	for name, count := range counts {
+		usage = append(usage, HelperUsage{Name: name, Packages: count})
+	}
+
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Packages != usage[j].Packages {
+			return usage[i].Packages > usage[j].Packages
+		}
+		return usage[i].Name < usage[j].Name
+	})
+
+	return usage
+}
@@ -0,0 +1,206 @@
+package metrics
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/benchmark"
+	"github.com/busser/adventofcode/registry"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSolution = `package d01
+
+import (
+	"io"
+
+	h "example.com/aoc/helpers"
+)
+
+// A point on the grid.
+type point struct{ x, y int }
+
+type (
+	grid  [][]byte
+	queue []point
+)
+
+// PartOne solves the first part of the puzzle.
+func PartOne(r io.Reader, w io.Writer) error {
+	lines, err := h.LinesFromReader(r)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		if line == "" || line == "#" {
+			continue
+		}
+	}
+
+	return nil
+}
+
+func PartTwo(r io.Reader, w io.Writer) error {
+	_ = h.IntsFromString
+	return nil
+}
+
+func (p point) add(o point) point { return point{p.x + o.x, p.y + o.y} }
+`
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "go.mod"), "module example.com/aoc\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"), "package d01\n\nfunc helper() {}\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d02", "solution.go"), "package d02\n\nfunc PartOne() {}\n")
+	writeTestFile(t, filepath.Join(workdir, "y2023", "d25", "solution.go"), "package d25\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "notes", "solution.go"), "package notes\n")
+
+	packages, err := Measure(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Package{
+		{
+			Year: 2023, Day: 25, Dir: filepath.Join("y2023", "d25"),
+			Lines:   1,
+			Helpers: []string{},
+		},
+		{
+			Year: 2024, Day: 1, Dir: filepath.Join("y2024", "d01"),
+			Lines: 27, Types: 3, Funcs: 3,
+			Parts: []Part{
+				{Part: 1, Complexity: 5},
+				{Part: 2, Complexity: 1},
+			},
+			Helpers: []string{"IntsFromString", "LinesFromReader"},
+		},
+		{
+			Year: 2024, Day: 2, Dir: filepath.Join("y2024", "d02"),
+			Lines: 2, Funcs: 1,
+			Parts:   []Part{{Part: 1, Complexity: 1}},
+			Helpers: []string{},
+		},
+	}
+	if diff := cmp.Diff(want, packages); diff != "" {
+		t.Errorf("packages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCyclomatic(t *testing.T) {
+	testCases := map[string]int{
+		`func f() {}`: 1,
+		`func f(a, b bool) { if a && b || a {} else if b {} }`:            5,
+		`func f(xs []int) { for range xs {}; for i := 0; i < 3; i++ {} }`: 3,
+		`func f(x int) { switch x { case 1, 2: case 3: default: } }`:      3,
+		`func f(c chan int) { select { case <-c: default: } }`:            2,
+		`func f() { g := func(a bool) { if a {} }; g(true) }`:             2,
+	}
+
+	for src, want := range testCases {
+		file, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+src, 0)
+		if err != nil {
+			t.Fatalf("parsing %q: %v", src, err)
+		}
+		fn := file.Decls[0].(*ast.FuncDecl)
+
+		if got := Cyclomatic(fn); got != want {
+			t.Errorf("Cyclomatic(%q) = %d, want %d", src, got, want)
+		}
+	}
+}
+
+func TestAddBenchmarks(t *testing.T) {
+	packages := []Package{
+		{Year: 2024, Day: 1, Parts: []Part{{Part: 1}, {Part: 2}}},
+		{Year: 2024, Day: 2, Parts: []Part{{Part: 1}}},
+	}
+	history := []benchmark.Record{
+		{Results: []benchmark.Result{
+			{Key: registry.Key{Year: 2024, Day: 1, Part: 1}, Median: 3 * time.Millisecond},
+			{Key: registry.Key{Year: 2024, Day: 1, Part: 2}, Median: 4 * time.Millisecond},
+		}},
+		{Results: []benchmark.Result{
+			{Key: registry.Key{Year: 2024, Day: 1, Part: 1}, Median: 2 * time.Millisecond},
+			{Key: registry.Key{Year: 2024, Day: 2, Part: 1}, Err: "boom"},
+		}},
+	}
+
+	AddBenchmarks(packages, history)
+
+	if got, want := packages[0].Median(), 6*time.Millisecond; got != want {
+		t.Errorf("got median %v for day 1, want %v", got, want)
+	}
+	if got := packages[1].Median(); got != 0 {
+		t.Errorf("got median %v for day 2, want none", got)
+	}
+}
+
+func TestReport(t *testing.T) {
+	packages := []Package{
+		{Year: 2023, Day: 1, Lines: 50, Types: 1, Funcs: 4, Parts: []Part{{1, 3, time.Millisecond}, {2, 4, 2 * time.Millisecond}}, Helpers: []string{"LinesFromReader"}},
+		{Year: 2024, Day: 1, Lines: 120, Types: 2, Funcs: 8, Parts: []Part{{1, 8, 0}}, Helpers: []string{"IntsFromString", "LinesFromReader"}},
+		{Year: 2024, Day: 2, Lines: 80, Funcs: 2, Parts: []Part{{1, 12, 0}, {2, 9, 0}}},
+	}
+
+	report, err := NewReport(packages, ByComplexity, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var b strings.Builder
+	if err := WriteReport(&b, report, FormatText); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `📏 Packages
+
+  Package  Lines  Types  Funcs  Part 1  Part 2  Helpers  Time
+  2024/02     80      0      2      12       9        0     -
+  2024/01    120      2      8       8       -        2     -
+
+🎄 Years
+
+  Year  Days  Lines  Lines/day  Types  Funcs  Time
+  2023     1     50         50      1      4   3ms
+  2024     2    200        100      2     10     -
+
+🧰 Helpers
+
+Helper           Packages
+LinesFromReader  2
+IntsFromString   1
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("report mismatch (-want +got):\n%s", diff)
+	}
+
+	// The input is not reordered.
+	if packages[0].Year != 2023 {
+		t.Errorf("NewReport reordered its input")
+	}
+
+	if _, err := NewReport(packages, "size", 0); err == nil {
+		t.Errorf("expected an error for an unknown metric")
+	}
+}
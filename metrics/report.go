@@ -0,0 +1,187 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/busser/adventofcode/benchmark"
+)
+
+// Formats supported by WriteReport.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Metrics packages can be ranked by.
+const (
+	ByLines      = "lines"
+	ByTypes      = "types"
+	ByFuncs      = "funcs"
+	ByComplexity = "complexity"
+	ByHelpers    = "helpers"
+	ByTime       = "time"
+)
+
+// rankings maps each metric to the value packages are ranked by.
+var rankings = map[string]func(Package) int64{
+	ByLines:      func(p Package) int64 { return int64(p.Lines) },
+	ByTypes:      func(p Package) int64 { return int64(p.Types) },
+	ByFuncs:      func(p Package) int64 { return int64(p.Funcs) },
+	ByComplexity: func(p Package) int64 { return int64(p.Complexity()) },
+	ByHelpers:    func(p Package) int64 { return int64(len(p.Helpers)) },
+	ByTime:       func(p Package) int64 { return int64(p.Median()) },
+}
+
+// Rank sorts packages by the given metric, highest first. Packages with the
+// same value keep their order.
+func Rank(packages []Package, by string) error {
+	value, ok := rankings[by]
+	if !ok {
+		return fmt.Errorf("unknown metric %q", by)
+	}
+
+	sort.SliceStable(packages, func(i, j int) bool {
+		return value(packages[i]) > value(packages[j])
+	})
+
+	return nil
+}
+
+// A Year sums the metrics of all packages of a year.
+type Year struct {
+	Year     int `json:"year"`
+	Packages int `json:"packages"`
+	Lines    int `json:"lines"`
+	Types    int `json:"types"`
+	Funcs    int `json:"funcs"`
+
+	// Sum of the median durations of all benchmarked parts.
+	Median time.Duration `json:"median_ns"`
+}
+
+// Years sums the metrics of packages by year, sorted by year.
+func Years(packages []Package) []Year {
+	byYear := make(map[int]*Year)
+	for _, p := range packages {
+		y, ok := byYear[p.Year]
+		if !ok {
+			y = &Year{Year: p.Year}
+			byYear[p.Year] = y
+		}
+
+		y.Packages++
+		y.Lines += p.Lines
+		y.Types += p.Types
+		y.Funcs += p.Funcs
+		for _, part := range p.Parts {
+			y.Median += part.Median
+		}
+	}
+
+	years := make([]Year, 0, len(byYear))
+	for _, y := range byYear {
+		years = append(years, *y)
+	}
+	sort.Slice(years, func(i, j int) bool { return years[i].Year < years[j].Year })
+
+	return years
+}
+
+// A Report summarizes metrics of packages.
+type Report struct {
+	// Ranked packages.
+	Packages []Package `json:"packages"`
+
+	Years   []Year        `json:"years"`
+	Helpers []HelperUsage `json:"helpers"`
+}
+
+// NewReport ranks packages by the given metric, and sums their metrics by year.
+// Only the first top packages are kept in the ranking, unless top is zero.
+func NewReport(packages []Package, by string, top int) (Report, error) {
+	report := Report{
+		Years:   Years(packages),
+		Helpers: Helpers(packages),
+	}
+
+	ranked := append([]Package(nil), packages...)
+	if err := Rank(ranked, by); err != nil {
+		return Report{}, err
+	}
+	if top > 0 && top < len(ranked) {
+		ranked = ranked[:top]
+	}
+	report.Packages = ranked
+
+	return report, nil
+}
+
+// WriteReport writes report to w in the given format.
+func WriteReport(w io.Writer, report Report, format string) error {
+	switch format {
+	case FormatText:
+		return writeText(w, report)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func writeText(w io.Writer, report Report) error {
+	fmt.Fprint(w, "📏 Packages\n\n")
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(tw, "Package\tLines\tTypes\tFuncs\tPart 1\tPart 2\tHelpers\tTime\t\n")
+	for _, p := range report.Packages {
+		complexity := []string{"-", "-"}
+		for _, part := range p.Parts {
+			complexity[part.Part-1] = strconv.Itoa(part.Complexity)
+		}
+		fmt.Fprintf(tw, "%d/%02d\t%d\t%d\t%d\t%s\t%s\t%d\t%s\t\n",
+			p.Year, p.Day, p.Lines, p.Types, p.Funcs,
+			complexity[0], complexity[1], len(p.Helpers), formatMedian(p.Median()),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "\n🎄 Years\n\n")
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprint(tw, "Year\tDays\tLines\tLines/day\tTypes\tFuncs\tTime\t\n")
+	for _, y := range report.Years {
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%d\t%d\t%s\t\n",
+			y.Year, y.Packages, y.Lines, y.Lines/y.Packages, y.Types, y.Funcs, formatMedian(y.Median),
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(w, "\n🧰 Helpers\n\n")
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, "Helper\tPackages\n")
+	for _, h := range report.Helpers {
+		fmt.Fprintf(tw, "%s\t%d\n", h.Name, h.Packages)
+	}
+	return tw.Flush()
+}
+
+// formatMedian formats a benchmark duration, or "-" if it is unknown.
+func formatMedian(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return benchmark.FormatDuration(d)
+}
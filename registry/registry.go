@@ -0,0 +1,80 @@
+// Package registry maps puzzles of the Advent of Code calendar to the
+// solutions implemented in this repository.
+package registry
+
+//go:generate go run ../scaffolding/genregistry -workdir ..
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/busser/adventofcode/helpers"
+)
+
+// A Key identifies one part of an Advent of Code puzzle.
+type Key struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+	Part int `json:"part"`
+}
+
+// String returns a human-readable representation of k.
+func (k Key) String() string {
+	return fmt.Sprintf("%d day %d part %d", k.Year, k.Day, k.Part)
+}
+
+// PackageDir returns the path of the package implementing k's solution,
+// relative to the root of the repository.
+func (k Key) PackageDir() string {
+	return fmt.Sprintf("y%04d/d%02d", k.Year, k.Day)
+}
+
+// InputFile returns the path to the puzzle input of k, relative to the root of
+// the repository.
+func (k Key) InputFile() string {
+	return filepath.Join(k.PackageDir(), "testdata", "input.txt")
+}
+
+// ProfileInputFile returns the path to the puzzle input of k for the given
+// profile, relative to the root of the repository. Without a profile, it is
+// the same as InputFile.
+func (k Key) ProfileInputFile(profile string) string {
+	if profile == "" {
+		return k.InputFile()
+	}
+	return filepath.Join(k.PackageDir(), "testdata", profile, "input.txt")
+}
+
+// An Entry is a solution registered for a specific puzzle part.
+type Entry struct {
+	Key
+	Solution helpers.Solution
+}
+
+// Lookup returns the solution registered for the given puzzle part, if any.
+func Lookup(year, day, part int) (helpers.Solution, bool) {
+	s, ok := solutions[Key{Year: year, Day: day, Part: part}]
+	return s, ok
+}
+
+// All returns every registered solution, sorted by year, day, and part.
+func All() []Entry {
+	entries := make([]Entry, 0, len(solutions))
+	for k, s := range solutions {
+		entries = append(entries, Entry{Key: k, Solution: s})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].Key, entries[j].Key
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Part < b.Part
+	})
+
+	return entries
+}
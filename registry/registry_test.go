@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"testing"
+)
+
+func ExampleLookup() {
+	solution, ok := Lookup(2024, 17, 1)
+	if !ok {
+		log.Fatal("no solution registered")
+	}
+
+	file, err := os.Open("../y2024/d17/testdata/input.txt")
+	if err != nil {
+		log.Fatalf("could not open input file: %v", err)
+	}
+	defer file.Close()
+
+	if err := solution.Solve(file, os.Stdout); err != nil {
+		log.Fatalf("could not solve: %v", err)
+	}
+	fmt.Println()
+	// Output: 1,0,2,0,5,7,2,1,3
+}
+
+func TestAll(t *testing.T) {
+	entries := All()
+
+	if len(entries) != len(solutions) {
+		t.Fatalf("expected %d entries, got %d", len(solutions), len(entries))
+	}
+
+	sorted := sort.SliceIsSorted(entries, func(i, j int) bool {
+		a, b := entries[i].Key, entries[j].Key
+		return a.Year*1000+a.Day*10+a.Part < b.Year*1000+b.Day*10+b.Part
+	})
+	if !sorted {
+		t.Fatalf("entries are not sorted")
+	}
+
+	for _, e := range entries {
+		if e.Solution == nil {
+			t.Errorf("%s: nil solution", e.Key)
+		}
+	}
+}
@@ -0,0 +1,428 @@
+// Code generated by genregistry. DO NOT EDIT.
+
+package registry
+
+import (
+	"github.com/busser/adventofcode/helpers"
+
+	y2015d01 "github.com/busser/adventofcode/y2015/d01"
+	y2015d02 "github.com/busser/adventofcode/y2015/d02"
+	y2015d03 "github.com/busser/adventofcode/y2015/d03"
+	y2015d04 "github.com/busser/adventofcode/y2015/d04"
+	y2015d05 "github.com/busser/adventofcode/y2015/d05"
+	y2015d06 "github.com/busser/adventofcode/y2015/d06"
+	y2019d01 "github.com/busser/adventofcode/y2019/d01"
+	y2019d02 "github.com/busser/adventofcode/y2019/d02"
+	y2019d05 "github.com/busser/adventofcode/y2019/d05"
+	y2020d01 "github.com/busser/adventofcode/y2020/d01"
+	y2020d02 "github.com/busser/adventofcode/y2020/d02"
+	y2020d03 "github.com/busser/adventofcode/y2020/d03"
+	y2020d04 "github.com/busser/adventofcode/y2020/d04"
+	y2020d05 "github.com/busser/adventofcode/y2020/d05"
+	y2020d06 "github.com/busser/adventofcode/y2020/d06"
+	y2020d07 "github.com/busser/adventofcode/y2020/d07"
+	y2020d08 "github.com/busser/adventofcode/y2020/d08"
+	y2020d09 "github.com/busser/adventofcode/y2020/d09"
+	y2020d10 "github.com/busser/adventofcode/y2020/d10"
+	y2020d11 "github.com/busser/adventofcode/y2020/d11"
+	y2020d12 "github.com/busser/adventofcode/y2020/d12"
+	y2020d13 "github.com/busser/adventofcode/y2020/d13"
+	y2020d14 "github.com/busser/adventofcode/y2020/d14"
+	y2020d15 "github.com/busser/adventofcode/y2020/d15"
+	y2020d16 "github.com/busser/adventofcode/y2020/d16"
+	y2020d17 "github.com/busser/adventofcode/y2020/d17"
+	y2020d18 "github.com/busser/adventofcode/y2020/d18"
+	y2020d19 "github.com/busser/adventofcode/y2020/d19"
+	y2020d20 "github.com/busser/adventofcode/y2020/d20"
+	y2020d21 "github.com/busser/adventofcode/y2020/d21"
+	y2020d22 "github.com/busser/adventofcode/y2020/d22"
+	y2020d23 "github.com/busser/adventofcode/y2020/d23"
+	y2020d24 "github.com/busser/adventofcode/y2020/d24"
+	y2020d25 "github.com/busser/adventofcode/y2020/d25"
+	y2021d01 "github.com/busser/adventofcode/y2021/d01"
+	y2021d02 "github.com/busser/adventofcode/y2021/d02"
+	y2021d03 "github.com/busser/adventofcode/y2021/d03"
+	y2021d04 "github.com/busser/adventofcode/y2021/d04"
+	y2021d05 "github.com/busser/adventofcode/y2021/d05"
+	y2021d06 "github.com/busser/adventofcode/y2021/d06"
+	y2021d07 "github.com/busser/adventofcode/y2021/d07"
+	y2021d08 "github.com/busser/adventofcode/y2021/d08"
+	y2021d09 "github.com/busser/adventofcode/y2021/d09"
+	y2021d10 "github.com/busser/adventofcode/y2021/d10"
+	y2021d11 "github.com/busser/adventofcode/y2021/d11"
+	y2021d12 "github.com/busser/adventofcode/y2021/d12"
+	y2021d13 "github.com/busser/adventofcode/y2021/d13"
+	y2021d14 "github.com/busser/adventofcode/y2021/d14"
+	y2021d15 "github.com/busser/adventofcode/y2021/d15"
+	y2021d16 "github.com/busser/adventofcode/y2021/d16"
+	y2021d17 "github.com/busser/adventofcode/y2021/d17"
+	y2021d18 "github.com/busser/adventofcode/y2021/d18"
+	y2021d19 "github.com/busser/adventofcode/y2021/d19"
+	y2021d20 "github.com/busser/adventofcode/y2021/d20"
+	y2021d21 "github.com/busser/adventofcode/y2021/d21"
+	y2021d22 "github.com/busser/adventofcode/y2021/d22"
+	y2021d23 "github.com/busser/adventofcode/y2021/d23"
+	y2021d24 "github.com/busser/adventofcode/y2021/d24"
+	y2021d25 "github.com/busser/adventofcode/y2021/d25"
+	y2022d01 "github.com/busser/adventofcode/y2022/d01"
+	y2022d02 "github.com/busser/adventofcode/y2022/d02"
+	y2022d03 "github.com/busser/adventofcode/y2022/d03"
+	y2022d04 "github.com/busser/adventofcode/y2022/d04"
+	y2022d05 "github.com/busser/adventofcode/y2022/d05"
+	y2022d06 "github.com/busser/adventofcode/y2022/d06"
+	y2022d07 "github.com/busser/adventofcode/y2022/d07"
+	y2022d08 "github.com/busser/adventofcode/y2022/d08"
+	y2022d09 "github.com/busser/adventofcode/y2022/d09"
+	y2022d10 "github.com/busser/adventofcode/y2022/d10"
+	y2022d11 "github.com/busser/adventofcode/y2022/d11"
+	y2022d12 "github.com/busser/adventofcode/y2022/d12"
+	y2022d13 "github.com/busser/adventofcode/y2022/d13"
+	y2022d14 "github.com/busser/adventofcode/y2022/d14"
+	y2022d15 "github.com/busser/adventofcode/y2022/d15"
+	y2022d16 "github.com/busser/adventofcode/y2022/d16"
+	y2022d17 "github.com/busser/adventofcode/y2022/d17"
+	y2022d18 "github.com/busser/adventofcode/y2022/d18"
+	y2022d19 "github.com/busser/adventofcode/y2022/d19"
+	y2022d20 "github.com/busser/adventofcode/y2022/d20"
+	y2022d21 "github.com/busser/adventofcode/y2022/d21"
+	y2022d22 "github.com/busser/adventofcode/y2022/d22"
+	y2022d23 "github.com/busser/adventofcode/y2022/d23"
+	y2022d24 "github.com/busser/adventofcode/y2022/d24"
+	y2022d25 "github.com/busser/adventofcode/y2022/d25"
+	y2023d01 "github.com/busser/adventofcode/y2023/d01"
+	y2023d02 "github.com/busser/adventofcode/y2023/d02"
+	y2023d03 "github.com/busser/adventofcode/y2023/d03"
+	y2023d04 "github.com/busser/adventofcode/y2023/d04"
+	y2023d05 "github.com/busser/adventofcode/y2023/d05"
+	y2023d06 "github.com/busser/adventofcode/y2023/d06"
+	y2023d07 "github.com/busser/adventofcode/y2023/d07"
+	y2023d08 "github.com/busser/adventofcode/y2023/d08"
+	y2023d09 "github.com/busser/adventofcode/y2023/d09"
+	y2023d10 "github.com/busser/adventofcode/y2023/d10"
+	y2023d11 "github.com/busser/adventofcode/y2023/d11"
+	y2023d12 "github.com/busser/adventofcode/y2023/d12"
+	y2023d13 "github.com/busser/adventofcode/y2023/d13"
+	y2023d14 "github.com/busser/adventofcode/y2023/d14"
+	y2023d15 "github.com/busser/adventofcode/y2023/d15"
+	y2023d16 "github.com/busser/adventofcode/y2023/d16"
+	y2023d17 "github.com/busser/adventofcode/y2023/d17"
+	y2023d18 "github.com/busser/adventofcode/y2023/d18"
+	y2023d19 "github.com/busser/adventofcode/y2023/d19"
+	y2023d20 "github.com/busser/adventofcode/y2023/d20"
+	y2023d21 "github.com/busser/adventofcode/y2023/d21"
+	y2023d22 "github.com/busser/adventofcode/y2023/d22"
+	y2023d23 "github.com/busser/adventofcode/y2023/d23"
+	y2023d24 "github.com/busser/adventofcode/y2023/d24"
+	y2023d25 "github.com/busser/adventofcode/y2023/d25"
+	y2024d01 "github.com/busser/adventofcode/y2024/d01"
+	y2024d02 "github.com/busser/adventofcode/y2024/d02"
+	y2024d03 "github.com/busser/adventofcode/y2024/d03"
+	y2024d04 "github.com/busser/adventofcode/y2024/d04"
+	y2024d05 "github.com/busser/adventofcode/y2024/d05"
+	y2024d06 "github.com/busser/adventofcode/y2024/d06"
+	y2024d07 "github.com/busser/adventofcode/y2024/d07"
+	y2024d08 "github.com/busser/adventofcode/y2024/d08"
+	y2024d09 "github.com/busser/adventofcode/y2024/d09"
+	y2024d10 "github.com/busser/adventofcode/y2024/d10"
+	y2024d11 "github.com/busser/adventofcode/y2024/d11"
+	y2024d12 "github.com/busser/adventofcode/y2024/d12"
+	y2024d13 "github.com/busser/adventofcode/y2024/d13"
+	y2024d14 "github.com/busser/adventofcode/y2024/d14"
+	y2024d15 "github.com/busser/adventofcode/y2024/d15"
+	y2024d16 "github.com/busser/adventofcode/y2024/d16"
+	y2024d17 "github.com/busser/adventofcode/y2024/d17"
+	y2024d18 "github.com/busser/adventofcode/y2024/d18"
+	y2024d19 "github.com/busser/adventofcode/y2024/d19"
+	y2024d20 "github.com/busser/adventofcode/y2024/d20"
+	y2024d21 "github.com/busser/adventofcode/y2024/d21"
+	y2024d22 "github.com/busser/adventofcode/y2024/d22"
+	y2024d23 "github.com/busser/adventofcode/y2024/d23"
+	y2025d01 "github.com/busser/adventofcode/y2025/d01"
+	y2025d02 "github.com/busser/adventofcode/y2025/d02"
+	y2025d03 "github.com/busser/adventofcode/y2025/d03"
+	y2025d04 "github.com/busser/adventofcode/y2025/d04"
+	y2025d05 "github.com/busser/adventofcode/y2025/d05"
+	y2025d06 "github.com/busser/adventofcode/y2025/d06"
+	y2025d07 "github.com/busser/adventofcode/y2025/d07"
+	y2025d08 "github.com/busser/adventofcode/y2025/d08"
+)
+
+// solutions lists every puzzle part solved in this repository.
+var solutions = map[Key]helpers.Solution{
+	{Year: 2015, Day: 1, Part: 1}:  helpers.SolutionFunc(y2015d01.PartOne),
+	{Year: 2015, Day: 1, Part: 2}:  helpers.SolutionFunc(y2015d01.PartTwo),
+	{Year: 2015, Day: 2, Part: 1}:  helpers.SolutionFunc(y2015d02.PartOne),
+	{Year: 2015, Day: 2, Part: 2}:  helpers.SolutionFunc(y2015d02.PartTwo),
+	{Year: 2015, Day: 3, Part: 1}:  helpers.SolutionFunc(y2015d03.PartOne),
+	{Year: 2015, Day: 3, Part: 2}:  helpers.SolutionFunc(y2015d03.PartTwo),
+	{Year: 2015, Day: 4, Part: 1}:  helpers.SolutionFunc(y2015d04.PartOne),
+	{Year: 2015, Day: 4, Part: 2}:  helpers.SolutionFunc(y2015d04.PartTwo),
+	{Year: 2015, Day: 5, Part: 1}:  helpers.SolutionFunc(y2015d05.PartOne),
+	{Year: 2015, Day: 5, Part: 2}:  helpers.SolutionFunc(y2015d05.PartTwo),
+	{Year: 2015, Day: 6, Part: 1}:  helpers.SolutionFunc(y2015d06.PartOne),
+	{Year: 2015, Day: 6, Part: 2}:  helpers.SolutionFunc(y2015d06.PartTwo),
+	{Year: 2019, Day: 1, Part: 1}:  helpers.SolutionFunc(y2019d01.PartOne),
+	{Year: 2019, Day: 1, Part: 2}:  helpers.SolutionFunc(y2019d01.PartTwo),
+	{Year: 2019, Day: 2, Part: 1}:  helpers.SolutionFunc(y2019d02.PartOne),
+	{Year: 2019, Day: 2, Part: 2}:  helpers.SolutionFunc(y2019d02.PartTwo),
+	{Year: 2019, Day: 5, Part: 1}:  helpers.SolutionFunc(y2019d05.PartOne),
+	{Year: 2019, Day: 5, Part: 2}:  helpers.SolutionFunc(y2019d05.PartTwo),
+	{Year: 2020, Day: 1, Part: 1}:  helpers.SolutionFunc(y2020d01.PartOne),
+	{Year: 2020, Day: 1, Part: 2}:  helpers.SolutionFunc(y2020d01.PartTwo),
+	{Year: 2020, Day: 2, Part: 1}:  helpers.SolutionFunc(y2020d02.PartOne),
+	{Year: 2020, Day: 2, Part: 2}:  helpers.SolutionFunc(y2020d02.PartTwo),
+	{Year: 2020, Day: 3, Part: 1}:  helpers.SolutionFunc(y2020d03.PartOne),
+	{Year: 2020, Day: 3, Part: 2}:  helpers.SolutionFunc(y2020d03.PartTwo),
+	{Year: 2020, Day: 4, Part: 1}:  helpers.SolutionFunc(y2020d04.PartOne),
+	{Year: 2020, Day: 4, Part: 2}:  helpers.SolutionFunc(y2020d04.PartTwo),
+	{Year: 2020, Day: 5, Part: 1}:  helpers.SolutionFunc(y2020d05.PartOne),
+	{Year: 2020, Day: 5, Part: 2}:  helpers.SolutionFunc(y2020d05.PartTwo),
+	{Year: 2020, Day: 6, Part: 1}:  helpers.SolutionFunc(y2020d06.PartOne),
+	{Year: 2020, Day: 6, Part: 2}:  helpers.SolutionFunc(y2020d06.PartTwo),
+	{Year: 2020, Day: 7, Part: 1}:  helpers.SolutionFunc(y2020d07.PartOne),
+	{Year: 2020, Day: 7, Part: 2}:  helpers.SolutionFunc(y2020d07.PartTwo),
+	{Year: 2020, Day: 8, Part: 1}:  helpers.SolutionFunc(y2020d08.PartOne),
+	{Year: 2020, Day: 8, Part: 2}:  helpers.SolutionFunc(y2020d08.PartTwo),
+	{Year: 2020, Day: 9, Part: 1}:  helpers.SolutionFunc(y2020d09.PartOne),
+	{Year: 2020, Day: 9, Part: 2}:  helpers.SolutionFunc(y2020d09.PartTwo),
+	{Year: 2020, Day: 10, Part: 1}: helpers.SolutionFunc(y2020d10.PartOne),
+	{Year: 2020, Day: 10, Part: 2}: helpers.SolutionFunc(y2020d10.PartTwo),
+	{Year: 2020, Day: 11, Part: 1}: helpers.SolutionFunc(y2020d11.PartOne),
+	{Year: 2020, Day: 11, Part: 2}: helpers.SolutionFunc(y2020d11.PartTwo),
+	{Year: 2020, Day: 12, Part: 1}: helpers.SolutionFunc(y2020d12.PartOne),
+	{Year: 2020, Day: 12, Part: 2}: helpers.SolutionFunc(y2020d12.PartTwo),
+	{Year: 2020, Day: 13, Part: 1}: helpers.SolutionFunc(y2020d13.PartOne),
+	{Year: 2020, Day: 13, Part: 2}: helpers.SolutionFunc(y2020d13.PartTwo),
+	{Year: 2020, Day: 14, Part: 1}: helpers.SolutionFunc(y2020d14.PartOne),
+	{Year: 2020, Day: 14, Part: 2}: helpers.SolutionFunc(y2020d14.PartTwo),
+	{Year: 2020, Day: 15, Part: 1}: helpers.SolutionFunc(y2020d15.PartOne),
+	{Year: 2020, Day: 15, Part: 2}: helpers.SolutionFunc(y2020d15.PartTwo),
+	{Year: 2020, Day: 16, Part: 1}: helpers.SolutionFunc(y2020d16.PartOne),
+	{Year: 2020, Day: 16, Part: 2}: helpers.SolutionFunc(y2020d16.PartTwo),
+	{Year: 2020, Day: 17, Part: 1}: helpers.SolutionFunc(y2020d17.PartOne),
+	{Year: 2020, Day: 17, Part: 2}: helpers.SolutionFunc(y2020d17.PartTwo),
+	{Year: 2020, Day: 18, Part: 1}: helpers.SolutionFunc(y2020d18.PartOne),
+	{Year: 2020, Day: 18, Part: 2}: helpers.SolutionFunc(y2020d18.PartTwo),
+	{Year: 2020, Day: 19, Part: 1}: helpers.SolutionFunc(y2020d19.PartOne),
+	{Year: 2020, Day: 19, Part: 2}: helpers.SolutionFunc(y2020d19.PartTwo),
+	{Year: 2020, Day: 20, Part: 1}: helpers.SolutionFunc(y2020d20.PartOne),
+	{Year: 2020, Day: 20, Part: 2}: helpers.SolutionFunc(y2020d20.PartTwo),
+	{Year: 2020, Day: 21, Part: 1}: helpers.SolutionFunc(y2020d21.PartOne),
+	{Year: 2020, Day: 21, Part: 2}: helpers.SolutionFunc(y2020d21.PartTwo),
+	{Year: 2020, Day: 22, Part: 1}: helpers.SolutionFunc(y2020d22.PartOne),
+	{Year: 2020, Day: 22, Part: 2}: helpers.SolutionFunc(y2020d22.PartTwo),
+	{Year: 2020, Day: 23, Part: 1}: helpers.SolutionFunc(y2020d23.PartOne),
+	{Year: 2020, Day: 23, Part: 2}: helpers.SolutionFunc(y2020d23.PartTwo),
+	{Year: 2020, Day: 24, Part: 1}: helpers.SolutionFunc(y2020d24.PartOne),
+	{Year: 2020, Day: 24, Part: 2}: helpers.SolutionFunc(y2020d24.PartTwo),
+	{Year: 2020, Day: 25, Part: 1}: helpers.SolutionFunc(y2020d25.PartOne),
+	{Year: 2021, Day: 1, Part: 1}:  helpers.SolutionFunc(y2021d01.PartOne),
+	{Year: 2021, Day: 1, Part: 2}:  helpers.SolutionFunc(y2021d01.PartTwo),
+	{Year: 2021, Day: 2, Part: 1}:  helpers.SolutionFunc(y2021d02.PartOne),
+	{Year: 2021, Day: 2, Part: 2}:  helpers.SolutionFunc(y2021d02.PartTwo),
+	{Year: 2021, Day: 3, Part: 1}:  helpers.SolutionFunc(y2021d03.PartOne),
+	{Year: 2021, Day: 3, Part: 2}:  helpers.SolutionFunc(y2021d03.PartTwo),
+	{Year: 2021, Day: 4, Part: 1}:  helpers.SolutionFunc(y2021d04.PartOne),
+	{Year: 2021, Day: 4, Part: 2}:  helpers.SolutionFunc(y2021d04.PartTwo),
+	{Year: 2021, Day: 5, Part: 1}:  helpers.SolutionFunc(y2021d05.PartOne),
+	{Year: 2021, Day: 5, Part: 2}:  helpers.SolutionFunc(y2021d05.PartTwo),
+	{Year: 2021, Day: 6, Part: 1}:  helpers.SolutionFunc(y2021d06.PartOne),
+	{Year: 2021, Day: 6, Part: 2}:  helpers.SolutionFunc(y2021d06.PartTwo),
+	{Year: 2021, Day: 7, Part: 1}:  helpers.SolutionFunc(y2021d07.PartOne),
+	{Year: 2021, Day: 7, Part: 2}:  helpers.SolutionFunc(y2021d07.PartTwo),
+	{Year: 2021, Day: 8, Part: 1}:  helpers.SolutionFunc(y2021d08.PartOne),
+	{Year: 2021, Day: 8, Part: 2}:  helpers.SolutionFunc(y2021d08.PartTwo),
+	{Year: 2021, Day: 9, Part: 1}:  helpers.SolutionFunc(y2021d09.PartOne),
+	{Year: 2021, Day: 9, Part: 2}:  helpers.SolutionFunc(y2021d09.PartTwo),
+	{Year: 2021, Day: 10, Part: 1}: helpers.SolutionFunc(y2021d10.PartOne),
+	{Year: 2021, Day: 10, Part: 2}: helpers.SolutionFunc(y2021d10.PartTwo),
+	{Year: 2021, Day: 11, Part: 1}: helpers.SolutionFunc(y2021d11.PartOne),
+	{Year: 2021, Day: 11, Part: 2}: helpers.SolutionFunc(y2021d11.PartTwo),
+	{Year: 2021, Day: 12, Part: 1}: helpers.SolutionFunc(y2021d12.PartOne),
+	{Year: 2021, Day: 12, Part: 2}: helpers.SolutionFunc(y2021d12.PartTwo),
+	{Year: 2021, Day: 13, Part: 1}: helpers.SolutionFunc(y2021d13.PartOne),
+	{Year: 2021, Day: 13, Part: 2}: helpers.SolutionFunc(y2021d13.PartTwo),
+	{Year: 2021, Day: 14, Part: 1}: helpers.SolutionFunc(y2021d14.PartOne),
+	{Year: 2021, Day: 14, Part: 2}: helpers.SolutionFunc(y2021d14.PartTwo),
+	{Year: 2021, Day: 15, Part: 1}: helpers.SolutionFunc(y2021d15.PartOne),
+	{Year: 2021, Day: 15, Part: 2}: helpers.SolutionFunc(y2021d15.PartTwo),
+	{Year: 2021, Day: 16, Part: 1}: helpers.SolutionFunc(y2021d16.PartOne),
+	{Year: 2021, Day: 16, Part: 2}: helpers.SolutionFunc(y2021d16.PartTwo),
+	{Year: 2021, Day: 17, Part: 1}: helpers.SolutionFunc(y2021d17.PartOne),
+	{Year: 2021, Day: 17, Part: 2}: helpers.SolutionFunc(y2021d17.PartTwo),
+	{Year: 2021, Day: 18, Part: 1}: helpers.SolutionFunc(y2021d18.PartOne),
+	{Year: 2021, Day: 18, Part: 2}: helpers.SolutionFunc(y2021d18.PartTwo),
+	{Year: 2021, Day: 19, Part: 1}: helpers.SolutionFunc(y2021d19.PartOne),
+	{Year: 2021, Day: 19, Part: 2}: helpers.SolutionFunc(y2021d19.PartTwo),
+	{Year: 2021, Day: 20, Part: 1}: helpers.SolutionFunc(y2021d20.PartOne),
+	{Year: 2021, Day: 20, Part: 2}: helpers.SolutionFunc(y2021d20.PartTwo),
+	{Year: 2021, Day: 21, Part: 1}: helpers.SolutionFunc(y2021d21.PartOne),
+	{Year: 2021, Day: 21, Part: 2}: helpers.SolutionFunc(y2021d21.PartTwo),
+	{Year: 2021, Day: 22, Part: 1}: helpers.SolutionFunc(y2021d22.PartOne),
+	{Year: 2021, Day: 22, Part: 2}: helpers.SolutionFunc(y2021d22.PartTwo),
+	{Year: 2021, Day: 23, Part: 1}: helpers.SolutionFunc(y2021d23.PartOne),
+	{Year: 2021, Day: 23, Part: 2}: helpers.SolutionFunc(y2021d23.PartTwo),
+	{Year: 2021, Day: 24, Part: 1}: helpers.SolutionFunc(y2021d24.PartOne),
+	{Year: 2021, Day: 24, Part: 2}: helpers.SolutionFunc(y2021d24.PartTwo),
+	{Year: 2021, Day: 25, Part: 1}: helpers.SolutionFunc(y2021d25.PartOne),
+	{Year: 2022, Day: 1, Part: 1}:  helpers.SolutionFunc(y2022d01.PartOne),
+	{Year: 2022, Day: 1, Part: 2}:  helpers.SolutionFunc(y2022d01.PartTwo),
+	{Year: 2022, Day: 2, Part: 1}:  helpers.SolutionFunc(y2022d02.PartOne),
+	{Year: 2022, Day: 2, Part: 2}:  helpers.SolutionFunc(y2022d02.PartTwo),
+	{Year: 2022, Day: 3, Part: 1}:  helpers.SolutionFunc(y2022d03.PartOne),
+	{Year: 2022, Day: 3, Part: 2}:  helpers.SolutionFunc(y2022d03.PartTwo),
+	{Year: 2022, Day: 4, Part: 1}:  helpers.SolutionFunc(y2022d04.PartOne),
+	{Year: 2022, Day: 4, Part: 2}:  helpers.SolutionFunc(y2022d04.PartTwo),
+	{Year: 2022, Day: 5, Part: 1}:  helpers.SolutionFunc(y2022d05.PartOne),
+	{Year: 2022, Day: 5, Part: 2}:  helpers.SolutionFunc(y2022d05.PartTwo),
+	{Year: 2022, Day: 6, Part: 1}:  helpers.SolutionFunc(y2022d06.PartOne),
+	{Year: 2022, Day: 6, Part: 2}:  helpers.SolutionFunc(y2022d06.PartTwo),
+	{Year: 2022, Day: 7, Part: 1}:  helpers.SolutionFunc(y2022d07.PartOne),
+	{Year: 2022, Day: 7, Part: 2}:  helpers.SolutionFunc(y2022d07.PartTwo),
+	{Year: 2022, Day: 8, Part: 1}:  helpers.SolutionFunc(y2022d08.PartOne),
+	{Year: 2022, Day: 8, Part: 2}:  helpers.SolutionFunc(y2022d08.PartTwo),
+	{Year: 2022, Day: 9, Part: 1}:  helpers.SolutionFunc(y2022d09.PartOne),
+	{Year: 2022, Day: 9, Part: 2}:  helpers.SolutionFunc(y2022d09.PartTwo),
+	{Year: 2022, Day: 10, Part: 1}: helpers.SolutionFunc(y2022d10.PartOne),
+	{Year: 2022, Day: 10, Part: 2}: helpers.SolutionFunc(y2022d10.PartTwo),
+	{Year: 2022, Day: 11, Part: 1}: helpers.SolutionFunc(y2022d11.PartOne),
+	{Year: 2022, Day: 11, Part: 2}: helpers.SolutionFunc(y2022d11.PartTwo),
+	{Year: 2022, Day: 12, Part: 1}: helpers.SolutionFunc(y2022d12.PartOne),
+	{Year: 2022, Day: 12, Part: 2}: helpers.SolutionFunc(y2022d12.PartTwo),
+	{Year: 2022, Day: 13, Part: 1}: helpers.SolutionFunc(y2022d13.PartOne),
+	{Year: 2022, Day: 13, Part: 2}: helpers.SolutionFunc(y2022d13.PartTwo),
+	{Year: 2022, Day: 14, Part: 1}: helpers.SolutionFunc(y2022d14.PartOne),
+	{Year: 2022, Day: 14, Part: 2}: helpers.SolutionFunc(y2022d14.PartTwo),
+	{Year: 2022, Day: 15, Part: 1}: helpers.SolutionFunc(y2022d15.PartOne),
+	{Year: 2022, Day: 15, Part: 2}: helpers.SolutionFunc(y2022d15.PartTwo),
+	{Year: 2022, Day: 16, Part: 1}: helpers.SolutionFunc(y2022d16.PartOne),
+	{Year: 2022, Day: 16, Part: 2}: helpers.SolutionFunc(y2022d16.PartTwo),
+	{Year: 2022, Day: 17, Part: 1}: helpers.SolutionFunc(y2022d17.PartOne),
+	{Year: 2022, Day: 17, Part: 2}: helpers.SolutionFunc(y2022d17.PartTwo),
+	{Year: 2022, Day: 18, Part: 1}: helpers.SolutionFunc(y2022d18.PartOne),
+	{Year: 2022, Day: 18, Part: 2}: helpers.SolutionFunc(y2022d18.PartTwo),
+	{Year: 2022, Day: 19, Part: 1}: helpers.SolutionFunc(y2022d19.PartOne),
+	{Year: 2022, Day: 19, Part: 2}: helpers.SolutionFunc(y2022d19.PartTwo),
+	{Year: 2022, Day: 20, Part: 1}: helpers.SolutionFunc(y2022d20.PartOne),
+	{Year: 2022, Day: 20, Part: 2}: helpers.SolutionFunc(y2022d20.PartTwo),
+	{Year: 2022, Day: 21, Part: 1}: helpers.SolutionFunc(y2022d21.PartOne),
+	{Year: 2022, Day: 21, Part: 2}: helpers.SolutionFunc(y2022d21.PartTwo),
+	{Year: 2022, Day: 22, Part: 1}: helpers.SolutionFunc(y2022d22.PartOne),
+	{Year: 2022, Day: 22, Part: 2}: helpers.SolutionFunc(y2022d22.PartTwo),
+	{Year: 2022, Day: 23, Part: 1}: helpers.SolutionFunc(y2022d23.PartOne),
+	{Year: 2022, Day: 23, Part: 2}: helpers.SolutionFunc(y2022d23.PartTwo),
+	{Year: 2022, Day: 24, Part: 1}: helpers.SolutionFunc(y2022d24.PartOne),
+	{Year: 2022, Day: 24, Part: 2}: helpers.SolutionFunc(y2022d24.PartTwo),
+	{Year: 2022, Day: 25, Part: 1}: helpers.SolutionFunc(y2022d25.PartOne),
+	{Year: 2023, Day: 1, Part: 1}:  helpers.SolutionFunc(y2023d01.PartOne),
+	{Year: 2023, Day: 1, Part: 2}:  helpers.SolutionFunc(y2023d01.PartTwo),
+	{Year: 2023, Day: 2, Part: 1}:  helpers.SolutionFunc(y2023d02.PartOne),
+	{Year: 2023, Day: 2, Part: 2}:  helpers.SolutionFunc(y2023d02.PartTwo),
+	{Year: 2023, Day: 3, Part: 1}:  helpers.SolutionFunc(y2023d03.PartOne),
+	{Year: 2023, Day: 3, Part: 2}:  helpers.SolutionFunc(y2023d03.PartTwo),
+	{Year: 2023, Day: 4, Part: 1}:  helpers.SolutionFunc(y2023d04.PartOne),
+	{Year: 2023, Day: 4, Part: 2}:  helpers.SolutionFunc(y2023d04.PartTwo),
+	{Year: 2023, Day: 5, Part: 1}:  helpers.SolutionFunc(y2023d05.PartOne),
+	{Year: 2023, Day: 5, Part: 2}:  helpers.SolutionFunc(y2023d05.PartTwo),
+	{Year: 2023, Day: 6, Part: 1}:  helpers.SolutionFunc(y2023d06.PartOne),
+	{Year: 2023, Day: 6, Part: 2}:  helpers.SolutionFunc(y2023d06.PartTwo),
+	{Year: 2023, Day: 7, Part: 1}:  helpers.SolutionFunc(y2023d07.PartOne),
+	{Year: 2023, Day: 7, Part: 2}:  helpers.SolutionFunc(y2023d07.PartTwo),
+	{Year: 2023, Day: 8, Part: 1}:  helpers.SolutionFunc(y2023d08.PartOne),
+	{Year: 2023, Day: 8, Part: 2}:  helpers.SolutionFunc(y2023d08.PartTwo),
+	{Year: 2023, Day: 9, Part: 1}:  helpers.SolutionFunc(y2023d09.PartOne),
+	{Year: 2023, Day: 9, Part: 2}:  helpers.SolutionFunc(y2023d09.PartTwo),
+	{Year: 2023, Day: 10, Part: 1}: helpers.SolutionFunc(y2023d10.PartOne),
+	{Year: 2023, Day: 10, Part: 2}: helpers.SolutionFunc(y2023d10.PartTwo),
+	{Year: 2023, Day: 11, Part: 1}: helpers.SolutionFunc(y2023d11.PartOne),
+	{Year: 2023, Day: 11, Part: 2}: helpers.SolutionFunc(y2023d11.PartTwo),
+	{Year: 2023, Day: 12, Part: 1}: helpers.SolutionFunc(y2023d12.PartOne),
+	{Year: 2023, Day: 12, Part: 2}: helpers.SolutionFunc(y2023d12.PartTwo),
+	{Year: 2023, Day: 13, Part: 1}: helpers.SolutionFunc(y2023d13.PartOne),
+	{Year: 2023, Day: 13, Part: 2}: helpers.SolutionFunc(y2023d13.PartTwo),
+	{Year: 2023, Day: 14, Part: 1}: helpers.SolutionFunc(y2023d14.PartOne),
+	{Year: 2023, Day: 14, Part: 2}: helpers.SolutionFunc(y2023d14.PartTwo),
+	{Year: 2023, Day: 15, Part: 1}: helpers.SolutionFunc(y2023d15.PartOne),
+	{Year: 2023, Day: 15, Part: 2}: helpers.SolutionFunc(y2023d15.PartTwo),
+	{Year: 2023, Day: 16, Part: 1}: helpers.SolutionFunc(y2023d16.PartOne),
+	{Year: 2023, Day: 16, Part: 2}: helpers.SolutionFunc(y2023d16.PartTwo),
+	{Year: 2023, Day: 17, Part: 1}: helpers.SolutionFunc(y2023d17.PartOne),
+	{Year: 2023, Day: 17, Part: 2}: helpers.SolutionFunc(y2023d17.PartTwo),
+	{Year: 2023, Day: 18, Part: 1}: helpers.SolutionFunc(y2023d18.PartOne),
+	{Year: 2023, Day: 18, Part: 2}: helpers.SolutionFunc(y2023d18.PartTwo),
+	{Year: 2023, Day: 19, Part: 1}: helpers.SolutionFunc(y2023d19.PartOne),
+	{Year: 2023, Day: 19, Part: 2}: helpers.SolutionFunc(y2023d19.PartTwo),
+	{Year: 2023, Day: 20, Part: 1}: helpers.SolutionFunc(y2023d20.PartOne),
+	{Year: 2023, Day: 20, Part: 2}: helpers.SolutionFunc(y2023d20.PartTwo),
+	{Year: 2023, Day: 21, Part: 1}: helpers.SolutionFunc(y2023d21.PartOne),
+	{Year: 2023, Day: 21, Part: 2}: helpers.SolutionFunc(y2023d21.PartTwo),
+	{Year: 2023, Day: 22, Part: 1}: helpers.SolutionFunc(y2023d22.PartOne),
+	{Year: 2023, Day: 22, Part: 2}: helpers.SolutionFunc(y2023d22.PartTwo),
+	{Year: 2023, Day: 23, Part: 1}: helpers.SolutionFunc(y2023d23.PartOne),
+	{Year: 2023, Day: 23, Part: 2}: helpers.SolutionFunc(y2023d23.PartTwo),
+	{Year: 2023, Day: 24, Part: 1}: helpers.SolutionFunc(y2023d24.PartOne),
+	{Year: 2023, Day: 24, Part: 2}: helpers.SolutionFunc(y2023d24.PartTwo),
+	{Year: 2023, Day: 25, Part: 1}: helpers.SolutionFunc(y2023d25.PartOne),
+	{Year: 2024, Day: 1, Part: 1}:  helpers.SolutionFunc(y2024d01.PartOne),
+	{Year: 2024, Day: 1, Part: 2}:  helpers.SolutionFunc(y2024d01.PartTwo),
+	{Year: 2024, Day: 2, Part: 1}:  helpers.SolutionFunc(y2024d02.PartOne),
+	{Year: 2024, Day: 2, Part: 2}:  helpers.SolutionFunc(y2024d02.PartTwo),
+	{Year: 2024, Day: 3, Part: 1}:  helpers.SolutionFunc(y2024d03.PartOne),
+	{Year: 2024, Day: 3, Part: 2}:  helpers.SolutionFunc(y2024d03.PartTwo),
+	{Year: 2024, Day: 4, Part: 1}:  helpers.SolutionFunc(y2024d04.PartOne),
+	{Year: 2024, Day: 4, Part: 2}:  helpers.SolutionFunc(y2024d04.PartTwo),
+	{Year: 2024, Day: 5, Part: 1}:  helpers.SolutionFunc(y2024d05.PartOne),
+	{Year: 2024, Day: 5, Part: 2}:  helpers.SolutionFunc(y2024d05.PartTwo),
+	{Year: 2024, Day: 6, Part: 1}:  helpers.SolutionFunc(y2024d06.PartOne),
+	{Year: 2024, Day: 6, Part: 2}:  helpers.SolutionFunc(y2024d06.PartTwo),
+	{Year: 2024, Day: 7, Part: 1}:  helpers.SolutionFunc(y2024d07.PartOne),
+	{Year: 2024, Day: 7, Part: 2}:  helpers.SolutionFunc(y2024d07.PartTwo),
+	{Year: 2024, Day: 8, Part: 1}:  helpers.SolutionFunc(y2024d08.PartOne),
+	{Year: 2024, Day: 8, Part: 2}:  helpers.SolutionFunc(y2024d08.PartTwo),
+	{Year: 2024, Day: 9, Part: 1}:  helpers.SolutionFunc(y2024d09.PartOne),
+	{Year: 2024, Day: 9, Part: 2}:  helpers.SolutionFunc(y2024d09.PartTwo),
+	{Year: 2024, Day: 10, Part: 1}: helpers.SolutionFunc(y2024d10.PartOne),
+	{Year: 2024, Day: 10, Part: 2}: helpers.SolutionFunc(y2024d10.PartTwo),
+	{Year: 2024, Day: 11, Part: 1}: helpers.SolutionFunc(y2024d11.PartOne),
+	{Year: 2024, Day: 11, Part: 2}: helpers.SolutionFunc(y2024d11.PartTwo),
+	{Year: 2024, Day: 12, Part: 1}: helpers.SolutionFunc(y2024d12.PartOne),
+	{Year: 2024, Day: 12, Part: 2}: helpers.SolutionFunc(y2024d12.PartTwo),
+	{Year: 2024, Day: 13, Part: 1}: helpers.SolutionFunc(y2024d13.PartOne),
+	{Year: 2024, Day: 13, Part: 2}: helpers.SolutionFunc(y2024d13.PartTwo),
+	{Year: 2024, Day: 14, Part: 1}: helpers.SolutionFunc(y2024d14.PartOne),
+	{Year: 2024, Day: 14, Part: 2}: helpers.SolutionFunc(y2024d14.PartTwo),
+	{Year: 2024, Day: 15, Part: 1}: helpers.SolutionFunc(y2024d15.PartOne),
+	{Year: 2024, Day: 15, Part: 2}: helpers.SolutionFunc(y2024d15.PartTwo),
+	{Year: 2024, Day: 16, Part: 1}: helpers.SolutionFunc(y2024d16.PartOne),
+	{Year: 2024, Day: 16, Part: 2}: helpers.SolutionFunc(y2024d16.PartTwo),
+	{Year: 2024, Day: 17, Part: 1}: helpers.SolutionFunc(y2024d17.PartOne),
+	{Year: 2024, Day: 17, Part: 2}: helpers.SolutionFunc(y2024d17.PartTwo),
+	{Year: 2024, Day: 18, Part: 1}: helpers.SolutionFunc(y2024d18.PartOne),
+	{Year: 2024, Day: 18, Part: 2}: helpers.SolutionFunc(y2024d18.PartTwo),
+	{Year: 2024, Day: 19, Part: 1}: helpers.SolutionFunc(y2024d19.PartOne),
+	{Year: 2024, Day: 19, Part: 2}: helpers.SolutionFunc(y2024d19.PartTwo),
+	{Year: 2024, Day: 20, Part: 1}: helpers.SolutionFunc(y2024d20.PartOne),
+	{Year: 2024, Day: 20, Part: 2}: helpers.SolutionFunc(y2024d20.PartTwo),
+	{Year: 2024, Day: 21, Part: 1}: helpers.SolutionFunc(y2024d21.PartOne),
+	{Year: 2024, Day: 21, Part: 2}: helpers.SolutionFunc(y2024d21.PartTwo),
+	{Year: 2024, Day: 22, Part: 1}: helpers.SolutionFunc(y2024d22.PartOne),
+	{Year: 2024, Day: 22, Part: 2}: helpers.SolutionFunc(y2024d22.PartTwo),
+	{Year: 2024, Day: 23, Part: 1}: helpers.SolutionFunc(y2024d23.PartOne),
+	{Year: 2024, Day: 23, Part: 2}: helpers.SolutionFunc(y2024d23.PartTwo),
+	{Year: 2025, Day: 1, Part: 1}:  helpers.SolutionFunc(y2025d01.PartOne),
+	{Year: 2025, Day: 1, Part: 2}:  helpers.SolutionFunc(y2025d01.PartTwo),
+	{Year: 2025, Day: 2, Part: 1}:  helpers.SolutionFunc(y2025d02.PartOne),
+	{Year: 2025, Day: 2, Part: 2}:  helpers.SolutionFunc(y2025d02.PartTwo),
+	{Year: 2025, Day: 3, Part: 1}:  helpers.SolutionFunc(y2025d03.PartOne),
+	{Year: 2025, Day: 3, Part: 2}:  helpers.SolutionFunc(y2025d03.PartTwo),
+	{Year: 2025, Day: 4, Part: 1}:  helpers.SolutionFunc(y2025d04.PartOne),
+	{Year: 2025, Day: 4, Part: 2}:  helpers.SolutionFunc(y2025d04.PartTwo),
+	{Year: 2025, Day: 5, Part: 1}:  helpers.SolutionFunc(y2025d05.PartOne),
+	{Year: 2025, Day: 5, Part: 2}:  helpers.SolutionFunc(y2025d05.PartTwo),
+	{Year: 2025, Day: 6, Part: 1}:  helpers.SolutionFunc(y2025d06.PartOne),
+	{Year: 2025, Day: 6, Part: 2}:  helpers.SolutionFunc(y2025d06.PartTwo),
+	{Year: 2025, Day: 7, Part: 1}:  helpers.SolutionFunc(y2025d07.PartOne),
+	{Year: 2025, Day: 7, Part: 2}:  helpers.SolutionFunc(y2025d07.PartTwo),
+	{Year: 2025, Day: 8, Part: 1}:  helpers.SolutionFunc(y2025d08.PartOne),
+	{Year: 2025, Day: 8, Part: 2}:  helpers.SolutionFunc(y2025d08.PartTwo),
+}
@@ -0,0 +1,349 @@
+package scaffolding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// AnswerPlaceholder is the expected output of examples in scaffolded tests,
+// until the answer to the puzzle is known.
+const AnswerPlaceholder = "👉 Write the answer here 👈"
+
+// ErrAnswerExists is returned when trying to overwrite the known answer of an
+// example.
+var ErrAnswerExists = errors.New("example already has an answer")
+
+// partFuncNames maps puzzle parts to the functions that solve them.
+var partFuncNames = map[int]string{
+	1: "PartOne",
+	2: "PartTwo",
+}
+
+// PartFuncName returns the name of the function solving the given part of a
+// puzzle, or an empty string if the part does not exist.
+func PartFuncName(part int) string {
+	return partFuncNames[part]
+}
+
+// WriteExampleAnswer sets the expected output of the example testing the given
+// part in the test file at path. Unless force is true, it refuses to replace an
+// output other than AnswerPlaceholder.
+func WriteExampleAnswer(path string, part int, answer string, force bool) error {
+	funcName, ok := partFuncNames[part]
+	if !ok {
+		return fmt.Errorf("invalid part: %d", part)
+	}
+	name := "Example" + funcName
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %w", path, err)
+	}
+
+	output, err := findExampleOutput(file, part)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	current := ExampleOutputText(output)
+	if current == answer {
+		return nil
+	}
+	if !IsUnknownAnswer(current) && !force {
+		return fmt.Errorf("%s: %w: %q", name, ErrAnswerExists, current)
+	}
+
+	start := fset.Position(output.Pos()).Offset
+	end := fset.Position(output.End()).Offset
+	indent := src[bytes.LastIndexByte(src[:start], '\n')+1 : start]
+
+	edited := Splice(src, start, end, formatExampleOutput(answer, string(indent)))
+
+	code, err := format.Source(edited)
+	if err != nil {
+		return fmt.Errorf("formatting %q: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, code, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// ReadExampleAnswer returns the expected output of the example testing the
+// given part in the test file at path. The output is AnswerPlaceholder until
+// the answer is known.
+func ReadExampleAnswer(path string, part int) (string, error) {
+	if _, ok := partFuncNames[part]; !ok {
+		return "", fmt.Errorf("invalid part: %d", part)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %w", path, err)
+	}
+
+	output, err := findExampleOutput(file, part)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+
+	return ExampleOutputText(output), nil
+}
+
+// findExampleOutput returns the "Output:" comment of the example testing the
+// given part in file, or an error if there is none.
+func findExampleOutput(file *ast.File, part int) (*ast.CommentGroup, error) {
+	name := "Example" + partFuncNames[part]
+
+	fn, output := ExampleOutput(file, part)
+	switch {
+	case fn == nil:
+		return nil, fmt.Errorf("no %s function", name)
+	case output == nil:
+		return nil, fmt.Errorf("%s has no output comment", name)
+	}
+
+	return output, nil
+}
+
+// ExampleOutput returns the example testing the given part in file, and its
+// "Output:" comment. As with go test, the output comment is the last comment
+// of the example's body. The example is nil if file has none, and the comment
+// is nil if the example has no output comment.
+func ExampleOutput(file *ast.File, part int) (*ast.FuncDecl, *ast.CommentGroup) {
+	name := "Example" + partFuncNames[part]
+
+	var fn *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Recv == nil && f.Name.Name == name {
+			fn = f
+			break
+		}
+	}
+	if fn == nil || fn.Body == nil {
+		return nil, nil
+	}
+
+	var last *ast.CommentGroup
+	for _, group := range file.Comments {
+		if group.Pos() > fn.Body.Lbrace && group.End() < fn.Body.Rbrace {
+			last = group
+		}
+	}
+	if last == nil || !strings.HasPrefix(last.Text(), "Output:") {
+		return fn, nil
+	}
+
+	return fn, last
+}
+
+// ExampleOutputText returns the expected output declared in comment.
+func ExampleOutputText(comment *ast.CommentGroup) string {
+	text := strings.TrimPrefix(comment.Text(), "Output:")
+	return strings.TrimSpace(text)
+}
+
+// IsUnknownAnswer reports whether answer, the expected output of an example,
+// is not known yet.
+func IsUnknownAnswer(answer string) bool {
+	return answer == "" || answer == AnswerPlaceholder
+}
+
+// Splice returns src with the bytes between start and end replaced by s.
+func Splice(src []byte, start, end int, s string) []byte {
+	var b bytes.Buffer
+	b.Write(src[:start])
+	b.WriteString(s)
+	b.Write(src[end:])
+	return b.Bytes()
+}
+
+// formatExampleOutput returns an output comment expecting answer, where all
+// lines but the first are prefixed with indent.
+func formatExampleOutput(answer, indent string) string {
+	lines := strings.Split(answer, "\n")
+	if len(lines) == 1 {
+		return "// Output: " + answer
+	}
+
+	var b strings.Builder
+	b.WriteString("// Output:")
+	for _, line := range lines {
+		b.WriteString("\n" + indent + "//")
+		if line != "" {
+			b.WriteString(" " + line)
+		}
+	}
+	return b.String()
+}
+
+// answersVarName is the name of the variable holding the expected answers of
+// each profile, in scaffolded tests.
+const answersVarName = "answers"
+
+// WriteProfileAnswer sets the expected answer to the given part for a profile,
+// in the answers variable of the test file at path. Unless force is true, it
+// refuses to replace a different answer.
+func WriteProfileAnswer(path, profile string, part int, answer string, force bool) error {
+	if _, ok := partFuncNames[part]; !ok {
+		return fmt.Errorf("invalid part: %d", part)
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %w", path, err)
+	}
+
+	answers := findAnswersLiteral(file)
+	if answers == nil {
+		return fmt.Errorf("%s: no %s variable found", path, answersVarName)
+	}
+
+	// Replace the profile's entry if it exists, or add one at the end.
+	var values []string
+	start := fset.Position(answers.Rbrace).Offset
+	end := start
+	for _, elt := range answers.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok || stringLiteral(kv.Key) != profile {
+			continue
+		}
+
+		list, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return fmt.Errorf("%s: answers of %q are not a list", path, profile)
+		}
+		for _, v := range list.Elts {
+			values = append(values, stringLiteral(v))
+		}
+
+		start = fset.Position(kv.Pos()).Offset
+		end = fset.Position(kv.End()).Offset
+	}
+
+	for len(values) < part {
+		values = append(values, "")
+	}
+	if current := values[part-1]; current == answer {
+		return nil
+	} else if current != "" && !force {
+		return fmt.Errorf("%s of %s: %w: %q", partFuncNames[part], profile, ErrAnswerExists, current)
+	}
+	values[part-1] = answer
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	entry := fmt.Sprintf("%s: {%s}", strconv.Quote(profile), strings.Join(quoted, ", "))
+	if start == end {
+		entry += ",\n"
+		if before := bytes.TrimRight(src[:start], " \t"); !bytes.HasSuffix(before, []byte("\n")) {
+			entry = "\n" + entry
+		}
+	}
+
+	code, err := format.Source(Splice(src, start, end, entry))
+	if err != nil {
+		return fmt.Errorf("formatting %q: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, code, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// ReadProfileAnswer returns the expected answer to the given part for a
+// profile, from the answers variable of the test file at path. It returns an
+// empty string if the answer is unknown.
+func ReadProfileAnswer(path, profile string, part int) (string, error) {
+	if _, ok := partFuncNames[part]; !ok {
+		return "", fmt.Errorf("invalid part: %d", part)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %w", path, err)
+	}
+
+	answers := findAnswersLiteral(file)
+	if answers == nil {
+		return "", fmt.Errorf("%s: no %s variable found", path, answersVarName)
+	}
+
+	for _, elt := range answers.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok || stringLiteral(kv.Key) != profile {
+			continue
+		}
+		if list, ok := kv.Value.(*ast.CompositeLit); ok && part <= len(list.Elts) {
+			return stringLiteral(list.Elts[part-1]), nil
+		}
+	}
+
+	return "", nil
+}
+
+// findAnswersLiteral returns the composite literal assigned to the answers
+// variable of file, or nil if there is none.
+func findAnswersLiteral(file *ast.File) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if name.Name != answersVarName || i >= len(vs.Values) {
+					continue
+				}
+				if lit, ok := vs.Values[i].(*ast.CompositeLit); ok {
+					return lit
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// stringLiteral returns the value of expr if it is a string literal, or an
+// empty string otherwise.
+func stringLiteral(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return value
+}
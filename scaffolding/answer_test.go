@@ -0,0 +1,197 @@
+package scaffolding
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteExampleAnswer(t *testing.T) {
+	dir := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: dir, packageDir: dir}
+	templates, err := gen.loadTemplates()
+	if err != nil {
+		t.Fatalf("could not load templates: %v", err)
+	}
+	if err := gen.renderTemplateIntoFile(templates["solution_test.go"], "solution_test.go", gen.templateData()); err != nil {
+		t.Fatalf("could not render test template: %v", err)
+	}
+	path := filepath.Join(dir, "solution_test.go")
+
+	if err := WriteExampleAnswer(path, 1, "1234", false); err != nil {
+		t.Fatalf("could not write answer to part one: %v", err)
+	}
+	if err := WriteExampleAnswer(path, 2, "A\nB", false); err != nil {
+		t.Fatalf("could not write answer to part two: %v", err)
+	}
+
+	code := readFile(t, path)
+	if !strings.Contains(code, "\t// Output: 1234\n}") {
+		t.Errorf("part one answer missing from:\n%s", code)
+	}
+	if !strings.Contains(code, "\t// Output:\n\t// A\n\t// B\n}") {
+		t.Errorf("part two answer missing from:\n%s", code)
+	}
+	if strings.Contains(code, AnswerPlaceholder) {
+		t.Errorf("placeholder still present in:\n%s", code)
+	}
+
+	// Writing the same answer again is a no-op.
+	if err := WriteExampleAnswer(path, 1, "1234", false); err != nil {
+		t.Errorf("unexpected error when writing the same answer: %v", err)
+	}
+
+	// Overwriting a different answer requires force.
+	err = WriteExampleAnswer(path, 1, "5678", false)
+	if !errors.Is(err, ErrAnswerExists) {
+		t.Errorf("expected ErrAnswerExists, got %v", err)
+	}
+	if err := WriteExampleAnswer(path, 1, "5678", true); err != nil {
+		t.Fatalf("could not overwrite answer: %v", err)
+	}
+	if code := readFile(t, path); !strings.Contains(code, "\t// Output: 5678\n}") {
+		t.Errorf("part one answer not overwritten in:\n%s", code)
+	}
+}
+
+func TestWriteExampleAnswerMissingExample(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "solution_test.go")
+	code := "package d25\n\nfunc ExamplePartOne() {\n\t// Output: 42\n}\n"
+	if err := os.WriteFile(path, []byte(code), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteExampleAnswer(path, 2, "1", false); err == nil {
+		t.Errorf("expected error for missing example")
+	}
+	if err := WriteExampleAnswer(path, 3, "1", false); err == nil {
+		t.Errorf("expected error for invalid part")
+	}
+}
+
+func TestExampleOutput(t *testing.T) {
+	code := `package d25
+
+func ExamplePartOne() {
+	// Output: 1
+	// ignored by go test
+	_ = 0
+
+	// Output: 2
+}
+
+func ExamplePartTwo() {
+	// Output: 3
+	_ = 0
+	// not an output
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "solution_test.go", code, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Like go test, only the last comment of an example holds its output.
+	if fn, output := ExampleOutput(file, 1); fn == nil || output == nil || ExampleOutputText(output) != "2" {
+		t.Errorf("got output %v of part one, want 2", output)
+	}
+	if fn, output := ExampleOutput(file, 2); fn == nil || output != nil {
+		t.Errorf("got output %v of part two, want none", output)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read %q: %v", path, err)
+	}
+	return string(content)
+}
+
+func TestWriteProfileAnswer(t *testing.T) {
+	dir := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: dir, packageDir: dir}
+	templates, err := gen.loadTemplates()
+	if err != nil {
+		t.Fatalf("could not load templates: %v", err)
+	}
+	if err := gen.renderTemplateIntoFile(templates["solution_test.go"], "solution_test.go", gen.templateData()); err != nil {
+		t.Fatalf("could not render test template: %v", err)
+	}
+	path := filepath.Join(dir, "solution_test.go")
+
+	steps := []struct {
+		profile string
+		part    int
+		answer  string
+	}{
+		{"alice", 2, "5678"},
+		{"bob", 1, "42"},
+		{"alice", 1, "1234"},
+	}
+	for _, step := range steps {
+		if err := WriteProfileAnswer(path, step.profile, step.part, step.answer, false); err != nil {
+			t.Fatalf("could not write answer to part %d of %s: %v", step.part, step.profile, err)
+		}
+	}
+
+	want := `var answers = map[string][]string{
+	"alice": {"1234", "5678"},
+	"bob":   {"42"},
+}`
+	code := readFile(t, path)
+	if !strings.Contains(code, want) {
+		t.Errorf("answers not written as expected in:\n%s", code)
+	}
+
+	// Writing the same answer again is a no-op.
+	if err := WriteProfileAnswer(path, "bob", 1, "42", false); err != nil {
+		t.Errorf("unexpected error when writing the same answer: %v", err)
+	}
+
+	// Overwriting a different answer requires force.
+	err = WriteProfileAnswer(path, "bob", 1, "43", false)
+	if !errors.Is(err, ErrAnswerExists) {
+		t.Errorf("expected ErrAnswerExists, got %v", err)
+	}
+	if err := WriteProfileAnswer(path, "bob", 1, "43", true); err != nil {
+		t.Fatalf("could not overwrite answer: %v", err)
+	}
+	if code := readFile(t, path); !strings.Contains(code, `"bob":   {"43"},`) {
+		t.Errorf("answer of bob not overwritten in:\n%s", code)
+	}
+
+	for _, tc := range []struct {
+		profile string
+		part    int
+		want    string
+	}{
+		{"alice", 1, "1234"},
+		{"alice", 2, "5678"},
+		{"bob", 1, "43"},
+		{"bob", 2, ""},
+		{"carol", 1, ""},
+	} {
+		got, err := ReadProfileAnswer(path, tc.profile, tc.part)
+		if err != nil || got != tc.want {
+			t.Errorf("ReadProfileAnswer(%q, %d) = %q, %v; want %q", tc.profile, tc.part, got, err, tc.want)
+		}
+	}
+}
+
+func TestWriteProfileAnswerMissingVariable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "solution_test.go")
+	if err := os.WriteFile(path, []byte("package d01\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteProfileAnswer(path, "alice", 1, "42", false); err == nil {
+		t.Errorf("expected an error")
+	}
+}
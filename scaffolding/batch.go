@@ -0,0 +1,171 @@
+package scaffolding
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/cache"
+)
+
+// A Target is a puzzle to build scaffolding for.
+type Target struct {
+	Year, Day int
+}
+
+func (t Target) String() string {
+	return fmt.Sprintf("%d/%02d", t.Year, t.Day)
+}
+
+// Outcomes of scaffolding a target in a batch.
+const (
+	Created = "created"
+	Skipped = "skipped"
+	Failed  = "failed"
+)
+
+// A BatchResult is the outcome of scaffolding one target of a batch.
+type BatchResult struct {
+	Target
+	Outcome string
+	Err     error
+}
+
+// ParseRange parses a comma-separated list of numbers and inclusive ranges,
+// such as "1-5,8,10-12". Numbers are returned in the order they appear, and
+// must all be between min and max.
+func ParseRange(s string, min, max int) ([]int, error) {
+	var numbers []int
+
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+
+		first, last, isRange := strings.Cut(field, "-")
+
+		start, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %w", field, err)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(strings.TrimSpace(last))
+			if err != nil {
+				return nil, fmt.Errorf("invalid range %q: %w", field, err)
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid range %q: end is before start", field)
+		}
+		if start < min || end > max {
+			return nil, fmt.Errorf("invalid range %q: numbers must be between %d and %d", field, min, max)
+		}
+
+		for n := start; n <= end; n++ {
+			numbers = append(numbers, n)
+		}
+	}
+
+	return numbers, nil
+}
+
+// RunBatch builds scaffolding for every target, in order, with generators
+// configured as by NewGenerator. Targets whose package already has a solution
+// and an input are skipped, unless overwrite is true. A failure does not stop
+// the batch; it is reported in the target's result instead. Once all targets
+// are done, the registry of solutions is updated, unless all were skipped.
+func RunBatch(targets []Target, workdir, templatesDir, profile string, client *aoc.Client, cache *cache.Cache, overwrite bool) ([]BatchResult, error) {
+	results := make([]BatchResult, 0, len(targets))
+
+	for _, target := range targets {
+		result := BatchResult{Target: target}
+
+		gen, err := NewGenerator(target.Day, target.Year, workdir, templatesDir, profile, client, cache, overwrite)
+		switch {
+		case err != nil:
+			result.Outcome, result.Err = Failed, err
+		case !overwrite && gen.scaffolded():
+			fmt.Printf("⏭️  Skipping package: %s\n", gen.packageDir)
+			result.Outcome = Skipped
+		default:
+			if err := gen.Run(); err != nil {
+				fmt.Printf("  ❌ %v\n", err)
+				result.Outcome, result.Err = Failed, err
+			} else {
+				result.Outcome = Created
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	// Failed targets may have their code written already.
+	for _, r := range results {
+		if r.Outcome != Skipped {
+			if err := UpdateRegistry(workdir); err != nil {
+				return results, fmt.Errorf("updating registry: %w", err)
+			}
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// scaffolded reports whether gen's package already has a solution and, if
+// gen can download it or copy it from the cache, an input.
+func (gen *Generator) scaffolded() bool {
+	if !fileExists(filepath.Join(gen.packageDir, "solution.go")) {
+		return false
+	}
+
+	if info, err := os.Stat(gen.inputFile()); err == nil && info.Size() > 0 {
+		return true
+	}
+
+	if gen.client != nil && gen.client.Cookie != "" {
+		return false
+	}
+	if gen.cache != nil {
+		if _, ok, _ := gen.cache.Get(gen.year, gen.day, cache.Input); ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WriteBatchSummary writes the targets created, skipped, and failed by a batch
+// to w.
+func WriteBatchSummary(w io.Writer, results []BatchResult) error {
+	byOutcome := make(map[string][]BatchResult)
+	for _, r := range results {
+		byOutcome[r.Outcome] = append(byOutcome[r.Outcome], r)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "📋 %d created, %d skipped, %d failed\n",
+		len(byOutcome[Created]), len(byOutcome[Skipped]), len(byOutcome[Failed]))
+
+	for _, outcome := range []string{Created, Skipped} {
+		if len(byOutcome[outcome]) == 0 {
+			continue
+		}
+		var targets []string
+		for _, r := range byOutcome[outcome] {
+			targets = append(targets, r.Target.String())
+		}
+		fmt.Fprintf(&b, "  %s: %s\n", outcome, strings.Join(targets, " "))
+	}
+
+	for _, r := range byOutcome[Failed] {
+		fmt.Fprintf(&b, "  %s %s: %v\n", Failed, r.Target, r.Err)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,142 @@
+package scaffolding
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseRange(t *testing.T) {
+	testCases := map[string][]int{
+		"7":           {7},
+		"1-5":         {1, 2, 3, 4, 5},
+		"1-3,8,10-11": {1, 2, 3, 8, 10, 11},
+		" 2 - 3 , 5":  {2, 3, 5},
+	}
+	for s, want := range testCases {
+		got, err := ParseRange(s, 1, 25)
+		if err != nil {
+			t.Errorf("ParseRange(%q): unexpected error: %v", s, err)
+			continue
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("ParseRange(%q) mismatch (-want +got):\n%s", s, diff)
+		}
+	}
+
+	for _, s := range []string{"", "a", "1-", "5-1", "1,,2", "0-3", "20-26", "1-1000000000"} {
+		if _, err := ParseRange(s, 1, 25); err == nil {
+			t.Errorf("ParseRange(%q): expected an error", s)
+		}
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/2024/day/1":
+			fmt.Fprint(w, `<main><article class="day-desc"><h2>--- Day 1: Test ---</h2></article></main>`)
+		case "/2024/day/1/input", "/2024/day/3/input":
+			fmt.Fprint(w, "42\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	workdir := t.TempDir()
+
+	// Day 3 was scaffolded before, day 4 only lacks its input.
+	for _, path := range []string{"y2024/d03/solution.go", "y2024/d03/testdata/input.txt", "y2024/d04/solution.go"} {
+		path = filepath.Join(workdir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("existing"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	targets := []Target{{2024, 1}, {2024, 2}, {2024, 3}, {2024, 4}}
+	results, err := RunBatch(targets, workdir, "", "", client, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var outcomes []string
+	for _, r := range results {
+		outcomes = append(outcomes, r.Outcome)
+	}
+	want := []string{Created, Failed, Skipped, Failed}
+	if diff := cmp.Diff(want, outcomes); diff != "" {
+		t.Fatalf("outcomes mismatch (-want +got):\n%s", diff)
+	}
+	if !errors.Is(results[1].Err, aoc.ErrNotFound) {
+		t.Errorf("expected day 2 to fail with ErrNotFound, got %v", results[1].Err)
+	}
+
+	if got := readFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt")); got != "42\n" {
+		t.Errorf("unexpected input for day 1: %q", got)
+	}
+	if got := readFile(t, filepath.Join(workdir, "y2024", "d03", "solution.go")); got != "existing" {
+		t.Errorf("skipped package was modified: %q", got)
+	}
+
+	var summary strings.Builder
+	if err := WriteBatchSummary(&summary, results); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"📋 1 created, 1 skipped, 2 failed\n",
+		"  created: 2024/01\n",
+		"  skipped: 2024/03\n",
+		"  failed 2024/02: downloading input: ",
+	} {
+		if !strings.Contains(summary.String(), line) {
+			t.Errorf("summary does not contain %q:\n%s", line, summary.String())
+		}
+	}
+}
+
+func TestRunBatchUpdatesRegistry(t *testing.T) {
+	workdir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workdir, "go.mod"), []byte("module example.com/aoc\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(workdir, "registry"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Run leaves the registry to its callers.
+	gen, err := NewGenerator(1, 2024, workdir, "", "", nil, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fileExists(filepath.Join(workdir, RegistryFile)) {
+		t.Fatalf("Run updated the registry")
+	}
+
+	if _, err := RunBatch([]Target{{2024, 2}, {2024, 3}}, workdir, "", "", nil, nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	registry := readFile(t, filepath.Join(workdir, RegistryFile))
+	for _, path := range []string{"example.com/aoc/y2024/d01", "example.com/aoc/y2024/d02", "example.com/aoc/y2024/d03"} {
+		if !strings.Contains(registry, path) {
+			t.Errorf("registry does not import %s:\n%s", path, registry)
+		}
+	}
+}
@@ -1,21 +1,17 @@
 package scaffolding
 
 import (
-	_ "embed"
+	"bytes"
 	"errors"
 	"fmt"
-	"html/template"
-	"io"
-	"net/http"
+	"go/format"
 	"os"
 	"path/filepath"
-)
+	"strings"
+	"text/template"
 
-var (
-	//go:embed templates/solution.go.tmpl
-	solutionTemplate string
-	//go:embed templates/solution_test.go.tmpl
-	solutionTestTemplate string
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/cache"
 )
 
 // A Generator creates a directory with all contents required to kickstart
@@ -27,25 +23,45 @@ type Generator struct {
 	// The directory where all Advent of Code solutions are stored.
 	workdir string
 
-	// Session cookie for adventofcode.com.
-	cookie string
+	// Directory with templates overriding the embedded ones. Optional.
+	templatesDir string
+
+	// Profile whose input to download. Empty for the default input.
+	profile string
+
+	// Client for adventofcode.com.
+	client *aoc.Client
+
+	// Cache of inputs and puzzle descriptions. Optional.
+	cache *cache.Cache
 
 	// Whether to overwrite existing files.
 	overwrite bool
 
 	// Path to scaffolded directory.
 	packageDir string
+
+	// Description of the puzzle, once fetched.
+	puzzle *aoc.Puzzle
 }
 
-// NewGenerator builds a generator for the given date and author. If overwrite
-// is true, the generator will overwrite existing files.
-func NewGenerator(day, year int, workdir, cookie string, overwrite bool) (*Generator, error) {
+// NewGenerator builds a generator for the given date, which downloads inputs
+// with client. Templates found in templatesDir, if not empty, replace the
+// default templates with the same name. If profile is not empty, the input is
+// stored in testdata/<profile>/input.txt instead of testdata/input.txt. Inputs
+// and puzzle descriptions found in cache, if not nil, are used instead of
+// downloading them. If overwrite is true, the generator will overwrite existing
+// files.
+func NewGenerator(day, year int, workdir, templatesDir, profile string, client *aoc.Client, cache *cache.Cache, overwrite bool) (*Generator, error) {
 	gen := &Generator{
-		day:       day,
-		year:      year,
-		workdir:   workdir,
-		cookie:    cookie,
-		overwrite: overwrite,
+		day:          day,
+		year:         year,
+		workdir:      workdir,
+		templatesDir: templatesDir,
+		profile:      profile,
+		client:       client,
+		cache:        cache,
+		overwrite:    overwrite,
 	}
 
 	if err := gen.Initialize(); err != nil {
@@ -77,9 +93,23 @@ func (gen *Generator) Run() error {
 	if err := gen.CreatePackage(); err != nil {
 		return fmt.Errorf("creating package: %w", err)
 	}
+	// The puzzle's title is available to templates. Scaffolding works offline
+	// and before the puzzle unlocks, so a puzzle that cannot be fetched only
+	// leaves out its description and examples.
+	if gen.puzzle == nil {
+		if err := gen.FetchPuzzle(); err != nil {
+			fmt.Printf("  👉 Skipping puzzle description; %v\n", err)
+		}
+	}
 	if err := gen.WriteCode(); err != nil {
 		return fmt.Errorf("writing code: %w", err)
 	}
+	if err := gen.WriteDescription(); err != nil {
+		return fmt.Errorf("writing puzzle description: %w", err)
+	}
+	if err := gen.WriteExamples(); err != nil {
+		return fmt.Errorf("writing examples: %w", err)
+	}
 	if err := gen.DownloadInput(); err != nil {
 		return fmt.Errorf("downloading input: %w", err)
 	}
@@ -98,70 +128,345 @@ func (gen *Generator) CreatePackage() error {
 }
 
 // WriteCode builds Go scaffolding for implementing, testing, and benchmarking
-// solutions to Advent of Code problems.
+// solutions to Advent of Code problems. Every template is rendered into the
+// file of the same name, except for the examples test, which WriteExamples
+// renders.
 func (gen *Generator) WriteCode() error {
-	if err := gen.renderTemplateIntoFile(solutionTemplate, "solution.go"); err != nil {
-		return fmt.Errorf("creating %q: %w", "solution.go", err)
+	templates, err := gen.loadTemplates()
+	if err != nil {
+		return err
+	}
+
+	data := gen.templateData()
+
+	for _, name := range codeTemplates(templates) {
+		if err := gen.renderTemplateIntoFile(templates[name], name, data); err != nil {
+			return fmt.Errorf("creating %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateRegistry regenerates the registry of solutions in workdir, so that new
+// packages can be run from the CLI. Run leaves it to callers, so that
+// scaffolding several days updates the registry only once.
+func UpdateRegistry(workdir string) error {
+	if !fileExists(filepath.Join(workdir, "go.mod")) {
+		fmt.Println("  👉 Skipping registry update; no Go module in working directory.")
+		return nil
+	}
+	if _, err := os.Stat(filepath.Dir(filepath.Join(workdir, RegistryFile))); err != nil {
+		fmt.Println("  👉 Skipping registry update; no registry package in working directory.")
+		return nil
+	}
+
+	if err := GenerateRegistry(workdir); err != nil {
+		return err
+	}
+
+	fmt.Printf("  👉 Updated %s.\n", RegistryFile)
+
+	return nil
+}
+
+// FetchPuzzle downloads the description of the Advent of Code's daily puzzle,
+// for use by WriteDescription and WriteExamples. A cached description is used
+// instead if it includes both parts of the puzzle, or if the download fails.
+func (gen *Generator) FetchPuzzle() error {
+	cached, err := gen.cachedPuzzle()
+	if err != nil {
+		return err
+	}
+	if cached != nil && cached.Parts() >= 2 {
+		fmt.Println("  👉 Using cached puzzle description.")
+		gen.puzzle = cached
+		return nil
+	}
+
+	if gen.client == nil {
+		if cached == nil {
+			fmt.Println("  👉 Skipping puzzle download; no client provided.")
+		}
+		gen.puzzle = cached
+		return nil
+	}
+
+	page, err := gen.client.DownloadPuzzlePage(gen.year, gen.day)
+	if err != nil {
+		if cached == nil {
+			return fmt.Errorf("download failed: %w", err)
+		}
+		fmt.Printf("  👉 Using cached puzzle description; download failed: %v\n", err)
+		gen.puzzle = cached
+		return nil
+	}
+
+	puzzle, err := aoc.ParsePuzzle(gen.year, gen.day, string(page))
+	if err != nil {
+		return err
+	}
+
+	if gen.cache != nil {
+		if err := gen.cache.Put(gen.year, gen.day, cache.Puzzle, page); err != nil {
+			return fmt.Errorf("caching puzzle description: %w", err)
+		}
+	}
+
+	gen.puzzle = puzzle
+
+	return nil
+}
+
+// cachedPuzzle returns the puzzle description found in gen's cache, or nil if
+// there is none.
+func (gen *Generator) cachedPuzzle() (*aoc.Puzzle, error) {
+	if gen.cache == nil {
+		return nil, nil
+	}
+
+	page, ok, err := gen.cache.Get(gen.year, gen.day, cache.Puzzle)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	puzzle, err := aoc.ParsePuzzle(gen.year, gen.day, string(page))
+	if err != nil {
+		return nil, fmt.Errorf("parsing cached puzzle description: %w", err)
+	}
+
+	return puzzle, nil
+}
+
+// WriteDescription writes the description of the Advent of Code's daily
+// puzzle as Markdown to a README.md file. An existing file is updated when the
+// second part of the puzzle becomes available.
+func (gen *Generator) WriteDescription() error {
+	if gen.puzzle == nil {
+		return nil
+	}
+
+	path := filepath.Join(gen.packageDir, "README.md")
+
+	if fileExists(path) && !gen.overwrite {
+		existing, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %q: %w", path, err)
+		}
+		if gen.puzzle.Parts() < 2 || strings.Contains(string(existing), aoc.PartHeading(2)) {
+			fmt.Println("  👉 Skipping puzzle description; file already exists.")
+			return nil
+		}
+	}
+
+	client := gen.client
+	if client == nil {
+		client = aoc.NewClient("")
+	}
+
+	markdown := gen.puzzle.Markdown(client.PuzzleURL(gen.year, gen.day))
+	if err := os.WriteFile(path, []byte(markdown), 0644); err != nil {
+		return fmt.Errorf("writing puzzle description to file %q: %w", path, err)
+	}
+
+	fmt.Printf("  👉 Wrote description of %d part(s) to README.md.\n", gen.puzzle.Parts())
+
+	return nil
+}
+
+// WriteExamples writes the examples found in the puzzle's description to the
+// testdata directory, along with their expected answers, and generates tests
+// that run the solution against them. Answers that could not be detected are
+// left empty for the user to fill in, and their tests are skipped until then.
+// An existing test file is left untouched, unless gen overwrites files.
+func (gen *Generator) WriteExamples() error {
+	if gen.puzzle == nil {
+		return nil
+	}
+
+	examples := gen.puzzle.Examples()
+	if len(examples) == 0 {
+		fmt.Println("  👉 Skipping examples; none found in puzzle description.")
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Join(gen.packageDir, "testdata"), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %w", filepath.Join(gen.packageDir, "testdata"), err)
 	}
-	if err := gen.renderTemplateIntoFile(solutionTestTemplate, "solution_test.go"); err != nil {
-		return fmt.Errorf("creating %q: %w", "solution_test.go", err)
+
+	templates, err := gen.loadTemplates()
+	if err != nil {
+		return err
+	}
+
+	var testCases []ExampleTest
+
+	for i, example := range examples {
+		name := fmt.Sprintf("example%d", i+1)
+		inputFile := "testdata/" + name + ".txt"
+
+		if err := gen.writeFileUnlessExists(inputFile, example.Input); err != nil {
+			return err
+		}
+
+		for part := 1; part <= 2; part++ {
+			answer, ok := example.Answers[part]
+			if !ok {
+				continue
+			}
+
+			answerFile := fmt.Sprintf("testdata/%s-answer%d.txt", name, part)
+			if err := gen.writeFileUnlessExists(answerFile, answer); err != nil {
+				return err
+			}
+
+			testCases = append(testCases, ExampleTest{
+				Name:       partFuncNames[part] + "/" + name,
+				FuncName:   partFuncNames[part],
+				InputFile:  inputFile,
+				AnswerFile: answerFile,
+			})
+		}
+	}
+
+	path := filepath.Join(gen.packageDir, examplesTestFile)
+	if fileExists(path) && !gen.overwrite {
+		fmt.Printf("  👉 Skipping example tests; %s already exists.\n", examplesTestFile)
+		return nil
 	}
+
+	data := gen.templateData()
+	data.Examples = testCases
+
+	tmpl, err := template.New(examplesTestFile).Parse(templates[examplesTestFile])
+	if err != nil {
+		return fmt.Errorf("parsing template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("rendering template: %w", err)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", examplesTestFile, err)
+	}
+
+	if err := os.WriteFile(path, code, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+
+	fmt.Printf("  👉 Wrote %d example test(s) to %s.\n", len(testCases), examplesTestFile)
+
+	return nil
+}
+
+// writeFileUnlessExists writes content to the file at path, relative to the
+// package directory. Existing files that are not empty are left untouched,
+// unless gen overwrites files.
+func (gen *Generator) writeFileUnlessExists(path, content string) error {
+	fullPath := filepath.Join(gen.packageDir, filepath.FromSlash(path))
+
+	if info, err := os.Stat(fullPath); err == nil && info.Size() > 0 && !gen.overwrite {
+		return nil
+	}
+
+	if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", fullPath, err)
+	}
+
 	return nil
 }
 
 // DownloadInput fetches the Advent of Code's daily input and writes it to a
-// testdata directory.
+// testdata directory. Inputs found in gen's cache are not downloaded again.
 func (gen *Generator) DownloadInput() error {
-	path := filepath.Join(gen.packageDir, "testdata", "input.txt")
+	path := gen.inputFile()
 	if fileExists(path) && !gen.overwrite {
 		fmt.Println("  👉 Skipping input download; file already exists.")
 		return nil
 	}
 
-	if gen.cookie == "" {
+	if gen.cache != nil {
+		input, ok, err := gen.cache.Get(gen.year, gen.day, cache.Input)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if err := gen.writeInput(path, input); err != nil {
+				return err
+			}
+			fmt.Println("  👉 Copied input from cache.")
+			return nil
+		}
+	}
+
+	if gen.client == nil || gen.client.Cookie == "" {
 		fmt.Println("  👉 Skipping input download; no session cookie provided.")
 		return nil
 	}
 
-	url := fmt.Sprintf("https://adventofcode.com/%d/day/%d/input", gen.year, gen.day)
-
-	req, err := http.NewRequest("GET", url, nil)
+	input, err := gen.client.DownloadInput(gen.year, gen.day)
 	if err != nil {
-		return fmt.Errorf("preparing GET request to %q: %w", url, err)
+		return err
 	}
 
-	req.AddCookie(&http.Cookie{Name: "session", Value: gen.cookie})
+	if gen.cache != nil {
+		if err := gen.cache.Put(gen.year, gen.day, cache.Input, input); err != nil {
+			return fmt.Errorf("caching input: %w", err)
+		}
+	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("sending GET request to %q: %w", url, err)
+	if err := gen.writeInput(path, input); err != nil {
+		return err
 	}
-	defer resp.Body.Close()
 
-	input, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("reading response from %q: %w", url, err)
+	fmt.Printf("  👉 Downloaded input.\n")
+
+	return nil
+}
+
+// writeInput writes input to the file at path. The examples and benchmarks of
+// solution_test.go read testdata/input.txt, so when gen scaffolds for a
+// profile, input is also written there unless that file already exists.
+func (gen *Generator) writeInput(path string, input []byte) error {
+	if err := writeInputFile(path, input); err != nil {
+		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("adventofcode.com responded with %d: %s", resp.StatusCode, input)
+	if gen.profile == "" {
+		return nil
 	}
+	defaultPath := filepath.Join(gen.packageDir, "testdata", "input.txt")
+	if fileExists(defaultPath) {
+		return nil
+	}
+	return writeInputFile(defaultPath, input)
+}
 
+// writeInputFile writes input to the file at path, creating its directory if
+// needed.
+func writeInputFile(path string, input []byte) error {
 	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return fmt.Errorf("creating directory %q: %w", filepath.Dir(path), err)
 	}
 
-	err = os.WriteFile(path, input, 0644)
-	if err != nil {
+	if err := os.WriteFile(path, input, 0644); err != nil {
 		return fmt.Errorf("writing input to file %q: %w", path, err)
 	}
 
-	fmt.Printf("  👉 Downloaded input.\n")
-
 	return nil
 }
 
+// inputFile returns the path of the file gen stores the input in.
+func (gen *Generator) inputFile() string {
+	if gen.profile == "" {
+		return filepath.Join(gen.packageDir, "testdata", "input.txt")
+	}
+	return filepath.Join(gen.packageDir, "testdata", gen.profile, "input.txt")
+}
+
 func (gen *Generator) setPackageDir() {
 	gen.packageDir = filepath.Join(
 		gen.workdir,
@@ -170,33 +475,31 @@ func (gen *Generator) setPackageDir() {
 	)
 }
 
-func (gen *Generator) renderTemplateIntoFile(templateText, filename string) error {
-	path := filepath.Join(gen.packageDir, filename)
+// renderTemplateIntoFile renders templateText with data into the file with
+// the given name, relative to the package directory.
+func (gen *Generator) renderTemplateIntoFile(templateText, filename string, data TemplateData) error {
+	path := filepath.Join(gen.packageDir, filepath.FromSlash(filename))
 
 	if fileExists(path) && !gen.overwrite {
 		fmt.Printf("  👉 Skipping existing file %s.\n", filename)
 		return nil
 	}
 
-	tmpl, err := template.New("aoc").Parse(templateText)
+	tmpl, err := template.New(filename).Parse(templateText)
 	if err != nil {
 		return fmt.Errorf("parsing template: %w", err)
 	}
 
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %w", filepath.Dir(path), err)
+	}
+
 	f, err := os.Create(path)
 	if err != nil {
 		return fmt.Errorf("creating or opening file %q: %w", path, err)
 	}
 	defer f.Close()
 
-	data := struct {
-		Day, Year   int
-		PackageName string
-	}{
-		Day:  gen.day,
-		Year: gen.year,
-	}
-
 	err = tmpl.Execute(f, data)
 	if err != nil {
 		return fmt.Errorf("rendering template: %w", err)
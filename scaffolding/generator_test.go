@@ -0,0 +1,274 @@
+package scaffolding
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/cache"
+)
+
+func TestWriteDescription(t *testing.T) {
+	const (
+		partOne = `<article class="day-desc"><h2>--- Day 1: Test ---</h2><p>First part.</p></article>`
+		partTwo = `<article class="day-desc"><h2 id="part2">--- Part Two ---</h2><p>Second part.</p></article>`
+	)
+
+	page := partOne
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "<html><body><main>%s</main></body></html>", page)
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("")
+	client.BaseURL = server.URL
+
+	dir := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: dir, packageDir: dir, client: client}
+	path := filepath.Join(dir, "README.md")
+
+	if err := gen.FetchPuzzle(); err != nil {
+		t.Fatalf("could not fetch puzzle: %v", err)
+	}
+	if err := gen.WriteDescription(); err != nil {
+		t.Fatalf("could not write description: %v", err)
+	}
+	if readme := readFile(t, path); !strings.Contains(readme, "# Day 1: Test") || strings.Contains(readme, "Second part.") {
+		t.Fatalf("unexpected description of part one:\n%s", readme)
+	}
+
+	// Part two unlocks.
+	page = partOne + partTwo
+
+	if err := gen.FetchPuzzle(); err != nil {
+		t.Fatalf("could not fetch puzzle: %v", err)
+	}
+	if err := gen.WriteDescription(); err != nil {
+		t.Fatalf("could not update description: %v", err)
+	}
+	if readme := readFile(t, path); !strings.Contains(readme, "## Part Two\n\nSecond part.") {
+		t.Fatalf("unexpected description of both parts:\n%s", readme)
+	}
+}
+
+func TestWriteExamples(t *testing.T) {
+	const page = `<html><body><main>
+<article class="day-desc"><h2>--- Day 1: Test ---</h2>
+<pre><code>1
+2
+3
+</code></pre>
+<p>There are <code><em>3</em></code> lines.</p>
+</article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<pre><code>a
+b
+</code></pre>
+<p>What is the <em>answer</em>?</p>
+</article>
+</main></body></html>`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, page)
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("")
+	client.BaseURL = server.URL
+
+	dir := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: dir, packageDir: dir, client: client}
+
+	if err := gen.FetchPuzzle(); err != nil {
+		t.Fatalf("could not fetch puzzle: %v", err)
+	}
+	if err := gen.WriteExamples(); err != nil {
+		t.Fatalf("could not write examples: %v", err)
+	}
+
+	files := map[string]string{
+		"testdata/example1.txt":         "1\n2\n3\n",
+		"testdata/example1-answer1.txt": "3",
+		"testdata/example2.txt":         "a\nb\n",
+		"testdata/example2-answer2.txt": "",
+	}
+	for name, want := range files {
+		if got := readFile(t, filepath.Join(dir, name)); got != want {
+			t.Errorf("%s: got %q, want %q", name, got, want)
+		}
+	}
+
+	code := readFile(t, filepath.Join(dir, "examples_test.go"))
+	for _, want := range []string{
+		`"PartOne/example1": {`,
+		`answerFile: "testdata/example1-answer1.txt",`,
+		`"PartTwo/example2": {`,
+		`helpers.TestSolution(t, test.solution, test.inputFile, test.answerFile)`,
+		`t.Skipf("👉 Write the answer to %s", test.answerFile)`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("examples_test.go does not contain %q:\n%s", want, code)
+		}
+	}
+
+	// Changes to the tests are kept, unless files are overwritten.
+	path := filepath.Join(dir, "examples_test.go")
+	if err := os.WriteFile(path, []byte("edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.WriteExamples(); err != nil {
+		t.Fatalf("could not write examples: %v", err)
+	}
+	if got := readFile(t, path); got != "edited" {
+		t.Errorf("examples_test.go was overwritten")
+	}
+
+	gen.overwrite = true
+	if err := gen.WriteExamples(); err != nil {
+		t.Fatalf("could not write examples: %v", err)
+	}
+	if got := readFile(t, path); got != code {
+		t.Errorf("examples_test.go was not regenerated")
+	}
+}
+
+func TestCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/2024/day/1":
+			fmt.Fprint(w, `<main><article class="day-desc"><h2>--- Day 1: Test ---</h2><p>First part.</p></article></main>`)
+		case "/2024/day/1/input":
+			fmt.Fprint(w, "42\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	store := cache.New(t.TempDir(), "alice")
+
+	// The first checkout downloads the input and puzzle, and caches them.
+	first := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: first, packageDir: first, client: client, cache: store}
+	if err := gen.FetchPuzzle(); err != nil {
+		t.Fatalf("could not fetch puzzle: %v", err)
+	}
+	if err := gen.DownloadInput(); err != nil {
+		t.Fatalf("could not download input: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("got %d requests, want 2", requests)
+	}
+
+	// Another checkout gets the input from the cache, even when offline. The
+	// puzzle only has one part, so its download is attempted again in case
+	// the second part became available.
+	server.Close()
+
+	second := t.TempDir()
+	gen = &Generator{day: 1, year: 2024, workdir: second, packageDir: second, client: client, cache: store}
+	if err := gen.FetchPuzzle(); err != nil {
+		t.Fatalf("could not fetch puzzle: %v", err)
+	}
+	if gen.puzzle == nil || gen.puzzle.Title != "Test" {
+		t.Errorf("cached puzzle not used: %+v", gen.puzzle)
+	}
+	if err := gen.DownloadInput(); err != nil {
+		t.Fatalf("could not download input: %v", err)
+	}
+	if got := readFile(t, filepath.Join(second, "testdata", "input.txt")); got != "42\n" {
+		t.Errorf("got input %q from cache", got)
+	}
+
+	// The cache is specific to each account.
+	third := t.TempDir()
+	gen = &Generator{day: 1, year: 2024, workdir: third, packageDir: third, cache: cache.New(store.Dir(), "bob")}
+	if err := gen.DownloadInput(); err != nil {
+		t.Fatalf("could not download input: %v", err)
+	}
+	if fileExists(filepath.Join(third, "testdata", "input.txt")) {
+		t.Errorf("input of alice used for bob")
+	}
+}
+
+func TestDownloadInputForProfile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "42\n")
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	dir := t.TempDir()
+	gen := &Generator{day: 1, year: 2024, workdir: dir, packageDir: dir, profile: "alice", client: client}
+	if err := gen.DownloadInput(); err != nil {
+		t.Fatalf("could not download input: %v", err)
+	}
+
+	if got := readFile(t, filepath.Join(dir, "testdata", "alice", "input.txt")); got != "42\n" {
+		t.Errorf("got input %q", got)
+	}
+	if got := readFile(t, filepath.Join(dir, "testdata", "input.txt")); got != "42\n" {
+		t.Errorf("got default input %q", got)
+	}
+
+	// An existing default input belongs to another account, and is kept.
+	if err := os.WriteFile(filepath.Join(dir, "testdata", "input.txt"), []byte("7\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gen.profile = "bob"
+	if err := gen.DownloadInput(); err != nil {
+		t.Fatalf("could not download input: %v", err)
+	}
+	if got := readFile(t, filepath.Join(dir, "testdata", "input.txt")); got != "7\n" {
+		t.Errorf("default input overwritten with %q", got)
+	}
+}
+
+func TestRunOffline(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+	server.Close()
+
+	workdir := t.TempDir()
+
+	gen, err := NewGenerator(1, 2024, workdir, "", "", client, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The input cannot be downloaded, but the code is written and the puzzle
+	// only goes missing.
+	if err := gen.Run(); err == nil || !strings.Contains(err.Error(), "downloading input") {
+		t.Fatalf("expected an error downloading input, got %v", err)
+	}
+	if !fileExists(filepath.Join(workdir, "y2024", "d01", "solution.go")) {
+		t.Errorf("solution.go not written")
+	}
+
+	// Without a session cookie, the input is not downloaded and scaffolding
+	// succeeds.
+	client.Cookie = ""
+	gen, err = NewGenerator(2, 2024, workdir, "", "", client, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fileExists(filepath.Join(workdir, "y2024", "d02", "README.md")) {
+		t.Errorf("README.md written without a puzzle description")
+	}
+}
@@ -0,0 +1,21 @@
+// Command genregistry generates the registry of solutions used by the
+// adventofcode CLI. It is meant to be run with go generate.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+func main() {
+	workdir := flag.String("workdir", ".", "Your Advent of Code working directory")
+	flag.Parse()
+
+	if err := scaffolding.GenerateRegistry(*workdir); err != nil {
+		fmt.Fprintf(os.Stderr, "genregistry: %v\n", err)
+		os.Exit(1)
+	}
+}
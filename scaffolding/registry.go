@@ -0,0 +1,324 @@
+package scaffolding
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// RegistryFile is the path of the generated solution registry, relative to the
+// working directory.
+var RegistryFile = filepath.Join("registry", "solutions_gen.go")
+
+// Patterns of the names of the directories solutions are stored in, such as
+// y2024/d05. Their submatch is the year or day.
+var (
+	YearDirPattern = regexp.MustCompile(`^y(\d{4})$`)
+	DayDirPattern  = regexp.MustCompile(`^d(\d{2})$`)
+)
+
+// A SolutionDir is a directory following the yYYYY/dDD layout of solutions.
+type SolutionDir struct {
+	Year, Day int
+
+	// Path of the directory, relative to the working directory.
+	Dir string
+}
+
+// SolutionDirs returns the directories of workdir that follow the yYYYY/dDD
+// layout of solutions, sorted by year and day. They may not hold a solution.
+func SolutionDirs(workdir string) ([]SolutionDir, error) {
+	yearDirs, err := os.ReadDir(workdir)
+	if err != nil {
+		return nil, fmt.Errorf("listing directory %q: %w", workdir, err)
+	}
+
+	var dirs []SolutionDir
+
+	// Directory entries are sorted by name, which sorts years and days too.
+	for _, yearDir := range yearDirs {
+		yearMatch := YearDirPattern.FindStringSubmatch(yearDir.Name())
+		if !yearDir.IsDir() || yearMatch == nil {
+			continue
+		}
+		year, _ := strconv.Atoi(yearMatch[1])
+
+		dayDirs, err := os.ReadDir(filepath.Join(workdir, yearDir.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("listing directory %q: %w", yearDir.Name(), err)
+		}
+
+		for _, dayDir := range dayDirs {
+			dayMatch := DayDirPattern.FindStringSubmatch(dayDir.Name())
+			if !dayDir.IsDir() || dayMatch == nil {
+				continue
+			}
+			day, _ := strconv.Atoi(dayMatch[1])
+
+			dirs = append(dirs, SolutionDir{
+				Year: year,
+				Day:  day,
+				Dir:  filepath.Join(yearDir.Name(), dayDir.Name()),
+			})
+		}
+	}
+
+	return dirs, nil
+}
+
+// registeredPackage describes a solution package found in the working
+// directory.
+type registeredPackage struct {
+	Year, Day  int
+	ImportPath string
+	Alias      string
+	Parts      []registeredPart
+}
+
+// registeredPart describes one solved part of a puzzle.
+type registeredPart struct {
+	Number   int
+	FuncName string
+}
+
+var registryTemplate = template.Must(template.New("registry").Parse(`// Code generated by genregistry. DO NOT EDIT.
+
+package registry
+
+import (
+	"{{ .ModulePath }}/helpers"
+{{ range .Packages }}
+	{{ .Alias }} "{{ .ImportPath }}"
+{{- end }}
+)
+
+// solutions lists every puzzle part solved in this repository.
+var solutions = map[Key]helpers.Solution{
+{{- range $pkg := .Packages }}
+{{- range .Parts }}
+	{Year: {{ $pkg.Year }}, Day: {{ $pkg.Day }}, Part: {{ .Number }}}: helpers.SolutionFunc({{ $pkg.Alias }}.{{ .FuncName }}),
+{{- end }}
+{{- end }}
+}
+`))
+
+// GenerateRegistry scans workdir for solution packages and writes the solution
+// registry used by the CLI. A package is registered if it exports a PartOne or
+// PartTwo function with the signature of a helpers.SolutionFunc.
+func GenerateRegistry(workdir string) error {
+	code, err := renderRegistry(workdir)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(workdir, RegistryFile)
+	if err := os.WriteFile(path, code, 0644); err != nil {
+		return fmt.Errorf("writing registry to file %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// renderRegistry returns the source code of the solution registry for the
+// packages in workdir.
+func renderRegistry(workdir string) ([]byte, error) {
+	modulePath, err := ModulePath(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := findSolutionPackages(workdir, modulePath)
+	if err != nil {
+		return nil, err
+	}
+
+	data := struct {
+		ModulePath string
+		Packages   []registeredPackage
+	}{
+		ModulePath: modulePath,
+		Packages:   packages,
+	}
+
+	var buf bytes.Buffer
+	if err := registryTemplate.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("rendering registry: %w", err)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting registry: %w", err)
+	}
+
+	return code, nil
+}
+
+// findSolutionPackages returns all packages in workdir that match the
+// yYYYY/dDD layout and export at least one part of a solution.
+func findSolutionPackages(workdir, modulePath string) ([]registeredPackage, error) {
+	dirs, err := SolutionDirs(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []registeredPackage
+
+	for _, dir := range dirs {
+		parts, err := findSolutionParts(filepath.Join(workdir, dir.Dir))
+		if err != nil {
+			return nil, err
+		}
+		if len(parts) == 0 {
+			continue
+		}
+
+		slashed := filepath.ToSlash(dir.Dir)
+		packages = append(packages, registeredPackage{
+			Year:       dir.Year,
+			Day:        dir.Day,
+			ImportPath: modulePath + "/" + slashed,
+			Alias:      strings.ReplaceAll(slashed, "/", ""),
+			Parts:      parts,
+		})
+	}
+
+	return packages, nil
+}
+
+// SolutionParts returns the parts of the puzzle solved by the package in dir,
+// that is the parts for which the package exports a PartOne or PartTwo
+// function with the signature of a helpers.SolutionFunc.
+func SolutionParts(dir string) ([]int, error) {
+	parts, err := findSolutionParts(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make([]int, len(parts))
+	for i, p := range parts {
+		numbers[i] = p.Number
+	}
+	return numbers, nil
+}
+
+// findSolutionParts parses the Go files in dir and returns the parts of the
+// puzzle they solve.
+func findSolutionParts(dir string) ([]registeredPart, error) {
+	fset := token.NewFileSet()
+	notTest := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(fset, dir, notTest, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("parsing package %q: %w", dir, err)
+	}
+
+	found := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil {
+					continue
+				}
+				if isSolutionFunc(fn.Type, ioImportName(file)) {
+					found[fn.Name.Name] = true
+				}
+			}
+		}
+	}
+
+	var parts []registeredPart
+	for i, name := range []string{"PartOne", "PartTwo"} {
+		if found[name] {
+			parts = append(parts, registeredPart{Number: i + 1, FuncName: name})
+		}
+	}
+
+	return parts, nil
+}
+
+// isSolutionFunc reports whether fn has the func(io.Reader, io.Writer) error
+// signature, where io is the name under which the "io" package is imported.
+func isSolutionFunc(fn *ast.FuncType, io string) bool {
+	if io == "" || fn.TypeParams != nil {
+		return false
+	}
+
+	var params []ast.Expr
+	for _, field := range fn.Params.List {
+		n := max(len(field.Names), 1)
+		for range n {
+			params = append(params, field.Type)
+		}
+	}
+	if len(params) != 2 || !isSelector(params[0], io, "Reader") || !isSelector(params[1], io, "Writer") {
+		return false
+	}
+
+	if fn.Results == nil || len(fn.Results.List) != 1 || len(fn.Results.List[0].Names) > 1 {
+		return false
+	}
+	result, ok := fn.Results.List[0].Type.(*ast.Ident)
+	return ok && result.Name == "error"
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
+
+// ioImportName returns the name under which file imports the "io" package, or
+// an empty string if it does not.
+func ioImportName(file *ast.File) string {
+	for _, imp := range file.Imports {
+		if imp.Path.Value != `"io"` {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return "io"
+	}
+	return ""
+}
+
+// ModulePath reads the module path from the go.mod file in workdir.
+func ModulePath(workdir string) (string, error) {
+	path := filepath.Join(workdir, "go.mod")
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %w", path, err)
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if modulePath, ok := strings.CutPrefix(line, "module "); ok {
+			return strings.Trim(strings.TrimSpace(modulePath), `"`), nil
+		}
+	}
+	if err := s.Err(); err != nil {
+		return "", fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	return "", errors.New("go.mod does not declare a module path")
+}
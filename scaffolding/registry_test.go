@@ -0,0 +1,122 @@
+package scaffolding
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegistryIsUpToDate(t *testing.T) {
+	want, err := renderRegistry("..")
+	if err != nil {
+		t.Fatalf("could not render registry: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join("..", RegistryFile))
+	if err != nil {
+		t.Fatalf("could not read registry: %v", err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s is out of date; run go generate ./registry", RegistryFile)
+	}
+}
+
+func TestSolutionDirs(t *testing.T) {
+	workdir := t.TempDir()
+	for _, dir := range []string{"y2024/d10", "y2024/d02", "y2015/d01", "y2024/notes", "y2023", "helpers"} {
+		if err := os.MkdirAll(filepath.Join(workdir, filepath.FromSlash(dir)), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(workdir, "y2024", "d03"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs, err := SolutionDirs(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []SolutionDir{
+		{Year: 2015, Day: 1, Dir: filepath.Join("y2015", "d01")},
+		{Year: 2024, Day: 2, Dir: filepath.Join("y2024", "d02")},
+		{Year: 2024, Day: 10, Dir: filepath.Join("y2024", "d10")},
+	}
+	if diff := cmp.Diff(want, dirs); diff != "" {
+		t.Errorf("directories mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindSolutionParts(t *testing.T) {
+	testCases := []struct {
+		name string
+		code string
+		want []registeredPart
+	}{
+		{
+			name: "both_parts",
+			code: `package d01
+import "io"
+func PartOne(r io.Reader, w io.Writer) error { return nil }
+func PartTwo(input io.Reader, answer io.Writer) error { return nil }
+`,
+			want: []registeredPart{{1, "PartOne"}, {2, "PartTwo"}},
+		},
+		{
+			name: "only_part_one",
+			code: `package d25
+import "io"
+func PartOne(r io.Reader, w io.Writer) error { return nil }
+`,
+			want: []registeredPart{{1, "PartOne"}},
+		},
+		{
+			name: "renamed_import",
+			code: `package d01
+import stdio "io"
+func PartOne(r stdio.Reader, w stdio.Writer) error { return nil }
+`,
+			want: []registeredPart{{1, "PartOne"}},
+		},
+		{
+			name: "wrong_signatures",
+			code: `package d01
+import "io"
+type solver struct{}
+func (solver) PartOne(r io.Reader, w io.Writer) error { return nil }
+func PartTwo(r io.Reader) error { return nil }
+`,
+			want: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "solution.go")
+			if err := os.WriteFile(path, []byte(tc.code), 0644); err != nil {
+				t.Fatalf("could not write code: %v", err)
+			}
+
+			// Make sure the test case is valid Go code.
+			if _, err := parser.ParseFile(token.NewFileSet(), path, nil, 0); err != nil {
+				t.Fatalf("invalid test case: %v", err)
+			}
+
+			got, err := findSolutionParts(dir)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("parts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package scaffolding
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultModulePath is the module path used in templates when the working
+// directory has no go.mod file.
+const DefaultModulePath = "github.com/busser/adventofcode"
+
+// examplesTestFile is the file generated from the examples found in a puzzle's
+// description. Its template is only rendered by WriteExamples.
+const examplesTestFile = "examples_test.go"
+
+// templateSuffix is the extension of template files. Rendered files have the
+// same name, without this extension.
+const templateSuffix = ".tmpl"
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// TemplateData is the data available to templates.
+type TemplateData struct {
+	// The day and year of the puzzle.
+	Day, Year int
+
+	// Name and import path of the scaffolded package, and path of the module
+	// it belongs to.
+	PackageName string
+	PackagePath string
+	ModulePath  string
+
+	// Title of the puzzle, such as "Historian Hysteria". Empty if the puzzle
+	// could not be fetched.
+	Title string
+
+	// Whether the description of the second part of the puzzle is available.
+	PartTwoAvailable bool
+
+	// Tests to generate from the puzzle's examples. Only set when rendering
+	// examples_test.go.
+	Examples []ExampleTest
+}
+
+// An ExampleTest is a test of a solution against an example from the puzzle's
+// description.
+type ExampleTest struct {
+	Name       string
+	FuncName   string
+	InputFile  string
+	AnswerFile string
+}
+
+// loadTemplates returns the text of all templates, keyed by the path of the
+// file they render, relative to the package directory. Templates in gen's
+// template directory override embedded templates with the same name.
+func (gen *Generator) loadTemplates() (map[string]string, error) {
+	templates := make(map[string]string)
+
+	err := fs.WalkDir(embeddedTemplates, "templates", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(p, templateSuffix) {
+			return err
+		}
+
+		text, err := embeddedTemplates.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(p, "templates/"), templateSuffix)
+		templates[name] = string(text)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading embedded templates: %w", err)
+	}
+
+	if gen.templatesDir == "" {
+		return templates, nil
+	}
+
+	err = filepath.WalkDir(gen.templatesDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(p, templateSuffix) {
+			return err
+		}
+
+		text, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(gen.templatesDir, p)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(filepath.ToSlash(rel), templateSuffix)
+		templates[name] = string(text)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading templates from %q: %w", gen.templatesDir, err)
+	}
+
+	return templates, nil
+}
+
+// codeTemplates returns the names of the templates rendered by WriteCode,
+// sorted alphabetically.
+func codeTemplates(templates map[string]string) []string {
+	var names []string
+	for name := range templates {
+		if name != examplesTestFile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// templateData returns the data used to render templates for gen's package.
+func (gen *Generator) templateData() TemplateData {
+	modulePath, err := ModulePath(gen.workdir)
+	if err != nil {
+		modulePath = DefaultModulePath
+	}
+
+	packageName := fmt.Sprintf("d%02d", gen.day)
+
+	data := TemplateData{
+		Day:         gen.day,
+		Year:        gen.year,
+		PackageName: packageName,
+		PackagePath: path.Join(modulePath, fmt.Sprintf("y%04d", gen.year), packageName),
+		ModulePath:  modulePath,
+	}
+
+	if gen.puzzle != nil {
+		data.Title = gen.puzzle.Title
+		data.PartTwoAvailable = gen.puzzle.Parts() >= 2
+	}
+
+	return data
+}
@@ -0,0 +1,54 @@
+package scaffolding
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteCodeWithCustomTemplates(t *testing.T) {
+	templatesDir := t.TempDir()
+	custom := map[string]string{
+		"solution.go.tmpl":          "package {{ .PackageName }}\n\n// Custom solution for {{ .PackagePath }}.\n",
+		"notes/TODO.md.tmpl":        "# Day {{ .Day }}, {{ .Year }}\n",
+		"ignored-without-extension": "not a template",
+	}
+	for name, text := range custom {
+		path := filepath.Join(templatesDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir := t.TempDir()
+	gen := &Generator{day: 3, year: 2024, workdir: dir, templatesDir: templatesDir, packageDir: dir}
+
+	if err := gen.WriteCode(); err != nil {
+		t.Fatalf("could not write code: %v", err)
+	}
+
+	files := map[string]string{
+		"solution.go":   "package d03\n\n// Custom solution for " + DefaultModulePath + "/y2024/d03.\n",
+		"notes/TODO.md": "# Day 3, 2024\n",
+	}
+	for name, want := range files {
+		if got := readFile(t, filepath.Join(dir, filepath.FromSlash(name))); got != want {
+			t.Errorf("%s: got %q, want %q", name, got, want)
+		}
+	}
+
+	// Templates that are not overridden keep their default content.
+	if code := readFile(t, filepath.Join(dir, "solution_test.go")); !strings.Contains(code, "func Benchmark(b *testing.B)") {
+		t.Errorf("unexpected solution_test.go:\n%s", code)
+	}
+
+	for _, name := range []string{"ignored-without-extension", examplesTestFile} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s should not be rendered by WriteCode", name)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package scaffolding
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+// Default settings of RetryWhileLocked. The website's clock may lag slightly
+// behind ours, so a puzzle can still be missing for a few seconds after it
+// unlocks.
+const (
+	DefaultUnlockRetryWindow   = 2 * time.Minute
+	DefaultUnlockRetryInterval = 5 * time.Second
+)
+
+// A Clock tells the time and waits. Tests use a fake clock to avoid sleeping.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// SystemClock is the clock of the operating system.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time        { return time.Now() }
+func (systemClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// WaitForUnlock waits until the puzzle of the given day unlocks, writing a
+// countdown to w every second. It returns immediately if the puzzle is already
+// unlocked.
+func WaitForUnlock(clock Clock, year, day int, w io.Writer) {
+	unlock := aoc.UnlockTime(year, day)
+
+	left := unlock.Sub(clock.Now())
+	if left <= 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "⏳ Waiting for day %d of %d, unlocking at %s\n", day, year, unlock.Local().Format(time.DateTime))
+	for ; left > 0; left = unlock.Sub(clock.Now()) {
+		// Round up, so that the countdown never shows zero before the unlock.
+		fmt.Fprintf(w, "\r⏰ %s ", aoc.FormatClock(left+time.Second-1))
+		clock.Sleep(min(left, time.Second))
+	}
+	fmt.Fprint(w, "\r🔓 Unlocked!      \n")
+}
+
+// RetryWhileLocked calls fn until it succeeds or fails with an error other than
+// aoc.ErrNotFound, waiting interval between calls. It gives up once window has
+// passed since the first call, and returns the last error. Each retry is
+// reported to w.
+func RetryWhileLocked(clock Clock, window, interval time.Duration, w io.Writer, fn func() error) error {
+	deadline := clock.Now().Add(window)
+
+	for {
+		err := fn()
+		if !errors.Is(err, aoc.ErrNotFound) {
+			return err
+		}
+		if !clock.Now().Add(interval).Before(deadline) {
+			return err
+		}
+
+		fmt.Fprintf(w, "  🔒 Puzzle not available yet, retrying in %s\n", interval)
+		clock.Sleep(interval)
+	}
+}
@@ -0,0 +1,128 @@
+package scaffolding
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/busser/adventofcode/aoc"
+)
+
+// fakeClock is a clock whose time only moves when sleeping.
+type fakeClock struct {
+	now    time.Time
+	sleeps int
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.sleeps++
+}
+
+func TestWaitForUnlock(t *testing.T) {
+	unlock := aoc.UnlockTime(2024, 5)
+	clock := &fakeClock{now: unlock.Add(-90*time.Second - 500*time.Millisecond)}
+
+	var out strings.Builder
+	WaitForUnlock(clock, 2024, 5, &out)
+
+	if !clock.now.Equal(unlock) {
+		t.Errorf("waited until %v, want %v", clock.now, unlock)
+	}
+	if clock.sleeps != 91 {
+		t.Errorf("slept %d times, want 91", clock.sleeps)
+	}
+	for _, s := range []string{"\r⏰ 00:01:31 ", "\r⏰ 00:00:01 ", "🔓 Unlocked!"} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("output does not contain %q", s)
+		}
+	}
+
+	// An unlocked puzzle does not wait.
+	out.Reset()
+	clock.sleeps = 0
+	WaitForUnlock(clock, 2024, 5, &out)
+	if clock.sleeps != 0 || out.Len() != 0 {
+		t.Errorf("waited for an unlocked puzzle: %q", out.String())
+	}
+}
+
+func TestRetryWhileLocked(t *testing.T) {
+	unlock := aoc.UnlockTime(2024, 5)
+	clock := &fakeClock{now: unlock}
+
+	// The website only serves the puzzle a few seconds after it unlocks.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clock.now.Before(unlock.Add(12 * time.Second)) {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Path {
+		case "/2024/day/5":
+			fmt.Fprint(w, `<main><article class="day-desc"><h2>--- Day 5: Test ---</h2></article></main>`)
+		case "/2024/day/5/input":
+			fmt.Fprint(w, "42\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := aoc.NewClient("s3cr3t")
+	client.BaseURL = server.URL
+
+	workdir := t.TempDir()
+
+	gen, err := NewGenerator(5, 2024, workdir, "", "", client, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	err = RetryWhileLocked(clock, time.Minute, 5*time.Second, &out, gen.Run)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Count(out.String(), "retrying in 5s"); got != 3 {
+		t.Errorf("reported %d retries, want 3:\n%s", got, out.String())
+	}
+	if clock.sleeps != 3 {
+		t.Errorf("retried %d times, want 3", clock.sleeps)
+	}
+	if got := readFile(t, filepath.Join(workdir, "y2024", "d05", "testdata", "input.txt")); got != "42\n" {
+		t.Errorf("unexpected input: %q", got)
+	}
+
+	// Give up once the window has passed.
+	clock = &fakeClock{now: unlock}
+	calls := 0
+	err = RetryWhileLocked(clock, 20*time.Second, 5*time.Second, io.Discard, func() error {
+		calls++
+		return fmt.Errorf("downloading input: %w", aoc.ErrNotFound)
+	})
+	if !errors.Is(err, aoc.ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if calls != 4 {
+		t.Errorf("called %d times, want 4", calls)
+	}
+
+	// Other errors are not retried.
+	calls = 0
+	wantErr := errors.New("boom")
+	err = RetryWhileLocked(clock, time.Minute, 5*time.Second, io.Discard, func() error {
+		calls++
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) || calls != 1 {
+		t.Errorf("got error %v after %d calls, want %v after 1 call", err, calls, wantErr)
+	}
+}
@@ -0,0 +1,71 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/busser/adventofcode/benchmark"
+)
+
+// WriteResults writes results to w. Answers are compared to the expected
+// answers, if known, with a line-by-line diff for answers spanning several
+// lines.
+func WriteResults(w io.Writer, results []Result) error {
+	var b strings.Builder
+
+	for _, r := range results {
+		elapsed := benchmark.FormatDuration(r.Elapsed)
+
+		switch {
+		case r.Error != "":
+			fmt.Fprintf(&b, "💥 Part %d failed after %s: %s\n", r.Part, elapsed, r.Error)
+		case r.Expected == "":
+			fmt.Fprintf(&b, "❔ Part %d in %s:%s\n", r.Part, elapsed, formatAnswer(r.Answer))
+		case r.Answer == r.Expected:
+			fmt.Fprintf(&b, "✅ Part %d in %s:%s\n", r.Part, elapsed, formatAnswer(r.Answer))
+		case strings.Contains(r.Answer, "\n") || strings.Contains(r.Expected, "\n"):
+			fmt.Fprintf(&b, "❌ Part %d in %s, diff against expected answer:\n", r.Part, elapsed)
+			b.WriteString(lineDiff(r.Expected, r.Answer))
+		default:
+			fmt.Fprintf(&b, "❌ Part %d in %s: %s, expected %s\n", r.Part, elapsed, r.Answer, r.Expected)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatAnswer puts answers spanning several lines on their own lines, and
+// other answers after a space.
+func formatAnswer(answer string) string {
+	if !strings.Contains(answer, "\n") {
+		return " " + answer
+	}
+	return "\n    " + strings.ReplaceAll(answer, "\n", "\n    ")
+}
+
+// lineDiff compares expected and actual line by line. Matching lines are
+// prefixed with spaces, and mismatching lines with "-" for expected and "+"
+// for actual.
+func lineDiff(expected, actual string) string {
+	want := strings.Split(expected, "\n")
+	got := strings.Split(actual, "\n")
+
+	var b strings.Builder
+	for i := 0; i < max(len(want), len(got)); i++ {
+		switch {
+		case i < len(want) && i < len(got) && want[i] == got[i]:
+			fmt.Fprintf(&b, "    %s\n", want[i])
+		default:
+			if i < len(want) {
+				fmt.Fprintf(&b, "  - %s\n", want[i])
+			}
+			if i < len(got) {
+				fmt.Fprintf(&b, "  + %s\n", got[i])
+			}
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package watch
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteResults(t *testing.T) {
+	results := []Result{
+		{Part: 1, Answer: "42", Elapsed: 1234567 * time.Nanosecond, Expected: "42"},
+		{Part: 2, Answer: "41", Elapsed: 2 * time.Second, Expected: "42"},
+		{Part: 1, Answer: "7", Elapsed: 1500 * time.Nanosecond},
+		{Part: 2, Error: "invalid input", Elapsed: time.Microsecond},
+		{Part: 2, Answer: "#.#\n.#.\n#..", Expected: "#.#\n.#.\n#.#"},
+		{Part: 1, Answer: "#\n#", Elapsed: time.Millisecond},
+	}
+
+	var b strings.Builder
+	if err := WriteResults(&b, results); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `✅ Part 1 in 1.235ms: 42
+❌ Part 2 in 2s: 41, expected 42
+❔ Part 1 in 1.5µs: 7
+💥 Part 2 failed after 1µs: invalid input
+❌ Part 2 in 0s, diff against expected answer:
+    #.#
+    .#.
+  - #.#
+  + #..
+❔ Part 1 in 1ms:
+    #
+    #
+`
+	if got := b.String(); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
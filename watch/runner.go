@@ -0,0 +1,230 @@
+// Package watch reruns a solution whenever its code or input changes.
+package watch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+// runnerDir is the directory, relative to the working directory, where the
+// program running solutions is generated. Directories starting with a dot are
+// ignored by patterns like ./..., so the program does not interfere with the
+// rest of the module.
+var runnerDir = filepath.Join(".adventofcode", "watch")
+
+//go:embed runner.go.tmpl
+var runnerTemplate string
+
+// A Result is the outcome of running one part of a solution.
+type Result struct {
+	Part    int           `json:"part"`
+	Answer  string        `json:"answer"`
+	Elapsed time.Duration `json:"elapsed"`
+	Error   string        `json:"error,omitempty"`
+
+	// Expected answer, if known.
+	Expected string `json:"-"`
+}
+
+// A Runner builds and runs the solution of a puzzle against its input.
+type Runner struct {
+	workdir string
+	key     registry.Key
+	profile string
+
+	// Import path of the solution's package.
+	packagePath string
+}
+
+// NewRunner returns a runner for the solution of the given day, stored in
+// workdir. If profile is not empty, the solution runs against the input of the
+// profile, and its answers are compared to the profile's.
+func NewRunner(workdir string, year, day int, profile string) (*Runner, error) {
+	modulePath, err := scaffolding.ModulePath(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	key := registry.Key{Year: year, Day: day}
+
+	return &Runner{
+		workdir:     workdir,
+		key:         key,
+		profile:     profile,
+		packagePath: path.Join(modulePath, key.PackageDir()),
+	}, nil
+}
+
+// PackageDir returns the directory of the solution's package.
+func (r *Runner) PackageDir() string {
+	return filepath.Join(r.workdir, filepath.FromSlash(r.key.PackageDir()))
+}
+
+// Run builds the solution and runs all of its parts. It returns an error if
+// the solution does not compile; errors returned by the solution itself are
+// reported in results instead. The solution is stopped when ctx is done.
+func (r *Runner) Run(ctx context.Context) ([]Result, error) {
+	parts, err := scaffolding.SolutionParts(r.PackageDir())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no solution found in %s", r.PackageDir())
+	}
+
+	binary, err := r.build(ctx, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	inputFile := filepath.Join(r.workdir, r.key.ProfileInputFile(r.profile))
+	if _, err := os.Stat(inputFile); err != nil {
+		return nil, fmt.Errorf("reading input: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, binary, inputFile)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("running solution: %w\n%s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	results, err := parseResults(&stdout)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].Expected = r.expectedAnswer(results[i].Part)
+	}
+
+	return results, nil
+}
+
+// build generates and compiles a program running the given parts of the
+// solution, and returns the path of the compiled program.
+func (r *Runner) build(ctx context.Context, parts []int) (string, error) {
+	tmpl, err := template.New("runner").Parse(runnerTemplate)
+	if err != nil {
+		return "", fmt.Errorf("parsing runner template: %w", err)
+	}
+
+	type part struct {
+		Number   int
+		FuncName string
+	}
+	data := struct {
+		PackagePath string
+		Parts       []part
+	}{
+		PackagePath: r.packagePath,
+	}
+	for _, p := range parts {
+		data.Parts = append(data.Parts, part{Number: p, FuncName: scaffolding.PartFuncName(p)})
+	}
+
+	var src bytes.Buffer
+	if err := tmpl.Execute(&src, data); err != nil {
+		return "", fmt.Errorf("rendering runner: %w", err)
+	}
+
+	dir := filepath.Join(r.workdir, runnerDir, fmt.Sprintf("y%04dd%02d", r.key.Year, r.key.Day))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating directory %q: %w", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("writing runner: %w", err)
+	}
+
+	binary, err := filepath.Abs(filepath.Join(dir, "solve"))
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(r.workdir, dir)
+	if err != nil {
+		return "", err
+	}
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, "go", "build", "-o", binary, "./"+filepath.ToSlash(rel))
+	cmd.Dir = r.workdir
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", &BuildError{Output: strings.TrimSpace(output.String())}
+	}
+
+	return binary, nil
+}
+
+// expectedAnswer returns the known answer to the given part, or an empty
+// string if it is unknown.
+func (r *Runner) expectedAnswer(part int) string {
+	testFile := filepath.Join(r.PackageDir(), "solution_test.go")
+
+	var answer string
+	var err error
+	if r.profile == "" {
+		answer, err = scaffolding.ReadExampleAnswer(testFile, part)
+	} else {
+		answer, err = scaffolding.ReadProfileAnswer(testFile, r.profile, part)
+	}
+	if err != nil || answer == scaffolding.AnswerPlaceholder {
+		return ""
+	}
+
+	return answer
+}
+
+// parseResults reads the results printed by the generated program, one JSON
+// object per line.
+func parseResults(r *bytes.Buffer) ([]Result, error) {
+	var results []Result
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16<<20)
+	for s.Scan() {
+		var result Result
+		if err := json.Unmarshal(s.Bytes(), &result); err != nil {
+			return nil, fmt.Errorf("parsing result: %w", err)
+		}
+		results = append(results, result)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("reading results: %w", err)
+	}
+
+	return results, nil
+}
+
+// A BuildError is returned when a solution does not compile.
+type BuildError struct {
+	// Output of the compiler.
+	Output string
+}
+
+func (e *BuildError) Error() string {
+	return "build failed:\n" + e.Output
+}
@@ -0,0 +1,150 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDelay is how long Watch waits for changes to settle before running
+// the solution again.
+const DefaultDelay = 200 * time.Millisecond
+
+// Watch runs the solution of r once, then again whenever a Go file or a
+// testdata file of its package changes, until ctx is done. Changes less than
+// delay apart are handled as one, so that saving several files at once only
+// triggers one run. A run still in progress when a change happens is stopped.
+// Results are written to w.
+func Watch(ctx context.Context, r *Runner, delay time.Duration, w io.Writer) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	dirs := []string{r.PackageDir(), filepath.Join(r.PackageDir(), "testdata")}
+	if r.profile != "" {
+		dirs = append(dirs, filepath.Join(r.PackageDir(), "testdata", r.profile))
+	}
+	for _, dir := range dirs {
+		if err := watcher.Add(dir); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("watching %q: %w", dir, err)
+		}
+	}
+
+	changes := make(chan struct{}, 1)
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Has(fsnotify.Create) {
+					// Watch directories created after the start, such as
+					// testdata when the input is downloaded.
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						_ = watcher.Add(event.Name)
+					}
+				}
+				if relevant(event) {
+					select {
+					case changes <- struct{}{}:
+					default:
+					}
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				fmt.Fprintf(w, "⚠️  Watcher error: %v\n", err)
+			}
+		}
+	}()
+
+	settled := debounce(ctx, changes, delay)
+
+	fmt.Fprintf(w, "👀 Watching %s. Press Ctrl+C to stop.\n", r.PackageDir())
+
+	for {
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			fmt.Fprintf(w, "\n🔨 [%s] Running...\n", time.Now().Format(time.TimeOnly))
+			results, err := r.Run(runCtx)
+			if runCtx.Err() != nil {
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(w, "🚨 %v\n", err)
+				return
+			}
+			_ = WriteResults(w, results)
+		}()
+
+		select {
+		case <-done:
+		case <-settled:
+			// Stop the current run, and start a new one.
+			cancel()
+			<-done
+			continue
+		case <-ctx.Done():
+			cancel()
+			<-done
+			return nil
+		}
+		cancel()
+
+		select {
+		case <-settled:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// relevant reports whether event may change the outcome of the solution.
+func relevant(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	return strings.HasSuffix(event.Name, ".go") || strings.HasSuffix(event.Name, ".txt")
+}
+
+// debounce returns a channel that receives a value once no value was received
+// from in for the given delay, until ctx is done.
+func debounce(ctx context.Context, in <-chan struct{}, delay time.Duration) <-chan struct{} {
+	out := make(chan struct{})
+
+	go func() {
+		timer := time.NewTimer(delay)
+		timer.Stop()
+
+		for {
+			select {
+			case <-in:
+				timer.Reset(delay)
+			case <-timer.C:
+				select {
+				case out <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			}
+		}
+	}()
+
+	return out
+}
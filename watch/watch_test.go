@@ -0,0 +1,189 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// writeFiles writes files to dir, keyed by their path relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newTestModule creates a module with a solution to day 1 of 2024, which
+// counts the lines of its input, and returns its directory.
+func newTestModule(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/aoc\n\ngo 1.23\n",
+		"y2024/d01/solution.go": `package d01
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+func PartOne(r io.Reader, w io.Writer) error {
+	s := bufio.NewScanner(r)
+	lines := 0
+	for s.Scan() {
+		lines++
+	}
+	_, err := fmt.Fprint(w, lines)
+	return err
+}
+`,
+		"y2024/d01/solution_test.go": `package d01
+
+func ExamplePartOne() {
+	// Output: 3
+}
+`,
+		"y2024/d01/testdata/input.txt":       "a\nb\nc\n",
+		"y2024/d01/testdata/alice/input.txt": "a\n",
+	})
+
+	return dir
+}
+
+func TestRunner(t *testing.T) {
+	dir := newTestModule(t)
+
+	r, err := NewRunner(dir, 2024, 1, "")
+	if err != nil {
+		t.Fatalf("could not create runner: %v", err)
+	}
+
+	results, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatalf("could not run solution: %v", err)
+	}
+	if len(results) != 1 || results[0].Part != 1 || results[0].Answer != "3" || results[0].Expected != "3" {
+		t.Errorf("unexpected results: %+v", results)
+	}
+
+	// Profiles run against their own input.
+	r, err = NewRunner(dir, 2024, 1, "alice")
+	if err != nil {
+		t.Fatalf("could not create runner: %v", err)
+	}
+	results, err = r.Run(context.Background())
+	if err != nil {
+		t.Fatalf("could not run solution: %v", err)
+	}
+	if len(results) != 1 || results[0].Answer != "1" || results[0].Expected != "" {
+		t.Errorf("unexpected results for profile: %+v", results)
+	}
+
+	// Compilation errors are reported.
+	writeFiles(t, dir, map[string]string{"y2024/d01/broken.go": "package d01\n\nvar x int = \"\"\n"})
+	_, err = r.Run(context.Background())
+	var buildErr *BuildError
+	if !errors.As(err, &buildErr) || !strings.Contains(buildErr.Output, "broken.go") {
+		t.Errorf("expected a build error, got %v", err)
+	}
+}
+
+// syncBuffer is a buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatch(t *testing.T) {
+	dir := newTestModule(t)
+
+	r, err := NewRunner(dir, 2024, 1, "")
+	if err != nil {
+		t.Fatalf("could not create runner: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var output syncBuffer
+	done := make(chan error)
+	go func() { done <- Watch(ctx, r, 10*time.Millisecond, &output) }()
+
+	waitFor := func(want string) {
+		t.Helper()
+		deadline := time.Now().Add(30 * time.Second)
+		for !strings.Contains(output.String(), want) {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %q in output:\n%s", want, output.String())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	waitFor("✅ Part 1")
+
+	// Changing the input triggers a new run.
+	writeFiles(t, dir, map[string]string{"y2024/d01/testdata/input.txt": "a\nb\n"})
+	waitFor("❌ Part 1")
+	if !strings.Contains(output.String(), ": 2, expected 3") {
+		t.Errorf("unexpected output:\n%s", output.String())
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in := make(chan struct{})
+	out := debounce(ctx, in, 50*time.Millisecond)
+
+	// A burst of changes is handled as one.
+	for range 5 {
+		in <- struct{}{}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	select {
+	case <-out:
+	case <-time.After(time.Second):
+		t.Fatal("no value after burst")
+	}
+
+	select {
+	case <-out:
+		t.Fatal("burst handled more than once")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,543 @@
+package workspace
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+// Checks run by Diagnose.
+const (
+	// Go files must parse.
+	CheckSyntax = "syntax"
+	// Go files must belong to the dDD package, or dDD_test for tests.
+	CheckPackageName = "package-name"
+	// Packages must have a benchmark.
+	CheckBenchmark = "benchmark"
+	// Implemented parts must have an example with a known answer.
+	CheckExampleAnswer = "example-answer"
+	// Inputs must not be empty, and must have LF line endings.
+	CheckInput = "input"
+)
+
+// An Issue is a violation of the conventions of the working directory.
+type Issue struct {
+	Check string `json:"check"`
+
+	// Location of the issue. File is relative to the working directory.
+	File string `json:"file"`
+	Line int    `json:"line"`
+
+	Message string `json:"message"`
+
+	// Whether Fix can fix the issue.
+	Fixable bool `json:"fixable"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s:%d: %s (%s)", i.File, i.Line, i.Message, i.Check)
+}
+
+// Diagnose checks every package of every year found in workdir, and returns
+// the issues found, sorted by file and line.
+func Diagnose(workdir string) ([]Issue, error) {
+	dirs, err := scaffolding.SolutionDirs(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+
+	for _, dir := range dirs {
+		found, err := diagnosePackage(workdir, dir.Dir)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, found...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		return issues[i].Line < issues[j].Line
+	})
+
+	return issues, nil
+}
+
+// diagnosePackage checks the package in dir, relative to workdir.
+func diagnosePackage(workdir, dir string) ([]Issue, error) {
+	var issues []Issue
+
+	entries, err := os.ReadDir(filepath.Join(workdir, dir))
+	if err != nil {
+		return nil, fmt.Errorf("listing directory %q: %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+	files := make(map[string]*ast.File)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+
+		// Files are named relative to the working directory, so that positions
+		// are too.
+		name := filepath.Join(dir, entry.Name())
+		src, err := os.ReadFile(filepath.Join(workdir, name))
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %w", name, err)
+		}
+		file, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+
+		var errs scanner.ErrorList
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				issues = append(issues, Issue{
+					Check:   CheckSyntax,
+					File:    name,
+					Line:    e.Pos.Line,
+					Message: e.Msg,
+				})
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %w", name, err)
+		}
+
+		files[name] = file
+	}
+
+	issues = append(issues, checkPackageNames(fset, files, filepath.Base(dir))...)
+
+	// Other checks of Go files need the whole package to parse.
+	if hasSyntaxIssues(issues) {
+		return append(issues, checkInputs(workdir, dir)...), nil
+	}
+
+	parts, err := scaffolding.SolutionParts(filepath.Join(workdir, dir))
+	if err != nil {
+		return nil, err
+	}
+
+	issues = append(issues, checkBenchmark(files, dir, parts)...)
+	issues = append(issues, checkExamples(fset, files, dir, parts)...)
+	issues = append(issues, checkInputs(workdir, dir)...)
+
+	return issues, nil
+}
+
+func hasSyntaxIssues(issues []Issue) bool {
+	for _, i := range issues {
+		if i.Check == CheckSyntax {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPackageNames reports files whose package is not named after their
+// directory.
+func checkPackageNames(fset *token.FileSet, files map[string]*ast.File, want string) []Issue {
+	var issues []Issue
+
+	for name, file := range files {
+		got := file.Name.Name
+		if got == want || (strings.HasSuffix(name, "_test.go") && got == want+"_test") {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Check:   CheckPackageName,
+			File:    name,
+			Line:    fset.Position(file.Name.Pos()).Line,
+			Message: fmt.Sprintf("package %s should be named %s", got, want),
+			Fixable: true,
+		})
+	}
+
+	return issues
+}
+
+// checkBenchmark reports packages whose tests have no benchmark.
+func checkBenchmark(files map[string]*ast.File, dir string, parts []int) []Issue {
+	testFile := filepath.Join(dir, "solution_test.go")
+	file, hasTestFile := files[testFile]
+
+	// The added benchmark calls the solution directly, which external tests
+	// cannot do.
+	fixable := hasTestFile && len(parts) > 0 && !strings.HasSuffix(file.Name.Name, "_test")
+
+	for name, file := range files {
+		if !strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Benchmark") {
+				return nil
+			}
+		}
+	}
+
+	if !hasTestFile {
+		testFile = filepath.Join(dir, "solution.go")
+	}
+
+	return []Issue{{
+		Check:   CheckBenchmark,
+		File:    testFile,
+		Line:    1,
+		Message: "package has no benchmark",
+		Fixable: fixable,
+	}}
+}
+
+// checkExamples reports implemented parts without an example, or whose
+// example has no known answer.
+func checkExamples(fset *token.FileSet, files map[string]*ast.File, dir string, parts []int) []Issue {
+	var issues []Issue
+
+	for _, part := range parts {
+		name := "Example" + scaffolding.PartFuncName(part)
+
+		var fn *ast.FuncDecl
+		var output *ast.CommentGroup
+		for _, file := range files {
+			if fn, output = scaffolding.ExampleOutput(file, part); fn != nil {
+				break
+			}
+		}
+		if fn == nil {
+			issues = append(issues, Issue{
+				Check:   CheckExampleAnswer,
+				File:    filepath.Join(dir, "solution_test.go"),
+				Line:    1,
+				Message: fmt.Sprintf("%s is missing", name),
+			})
+			continue
+		}
+
+		switch {
+		case output == nil:
+			issues = append(issues, Issue{
+				Check:   CheckExampleAnswer,
+				File:    fset.Position(fn.Pos()).Filename,
+				Line:    fset.Position(fn.Pos()).Line,
+				Message: fmt.Sprintf("%s has no output comment", name),
+			})
+		case scaffolding.IsUnknownAnswer(scaffolding.ExampleOutputText(output)):
+			issues = append(issues, Issue{
+				Check:   CheckExampleAnswer,
+				File:    fset.Position(output.Pos()).Filename,
+				Line:    fset.Position(output.Pos()).Line,
+				Message: fmt.Sprintf("%s has no answer", name),
+			})
+		}
+	}
+
+	return issues
+}
+
+// checkInputs reports empty inputs, and inputs with CRLF line endings, in the
+// testdata directory of the package in dir.
+func checkInputs(workdir, dir string) []Issue {
+	paths, _ := filepath.Glob(filepath.Join(workdir, dir, "testdata", "*", "input.txt"))
+	paths = append([]string{filepath.Join(workdir, dir, "testdata", "input.txt")}, paths...)
+
+	var issues []Issue
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		name, _ := filepath.Rel(workdir, path)
+
+		if len(content) == 0 {
+			issues = append(issues, Issue{
+				Check:   CheckInput,
+				File:    name,
+				Line:    1,
+				Message: "input is empty",
+			})
+			continue
+		}
+
+		if i := bytes.Index(content, []byte("\r\n")); i >= 0 {
+			issues = append(issues, Issue{
+				Check:   CheckInput,
+				File:    name,
+				Line:    bytes.Count(content[:i], []byte("\n")) + 1,
+				Message: "input has CRLF line endings",
+				Fixable: true,
+			})
+		}
+	}
+
+	return issues
+}
+
+// Fix fixes all fixable issues, and returns the issues it fixed. Issues must
+// have been found by Diagnose in the same working directory.
+func Fix(workdir string, issues []Issue) ([]Issue, error) {
+	var fixed []Issue
+
+	for _, issue := range issues {
+		if !issue.Fixable {
+			continue
+		}
+
+		var err error
+		switch issue.Check {
+		case CheckPackageName:
+			err = fixPackageName(workdir, issue.File)
+		case CheckBenchmark:
+			err = fixBenchmark(workdir, issue.File)
+		case CheckInput:
+			err = fixLineEndings(workdir, issue.File)
+		default:
+			err = fmt.Errorf("no fix for check %q", issue.Check)
+		}
+		if err != nil {
+			return fixed, fmt.Errorf("fixing %s: %w", issue, err)
+		}
+
+		fixed = append(fixed, issue)
+	}
+
+	return fixed, nil
+}
+
+// fixPackageName renames the package of a file after its directory.
+func fixPackageName(workdir, name string) error {
+	path := filepath.Join(workdir, name)
+
+	return editGoFile(path, func(fset *token.FileSet, file *ast.File, src []byte) ([]byte, error) {
+		want := filepath.Base(filepath.Dir(path))
+		if strings.HasSuffix(name, "_test.go") && strings.HasSuffix(file.Name.Name, "_test") {
+			want += "_test"
+		}
+
+		start := fset.Position(file.Name.Pos()).Offset
+		end := fset.Position(file.Name.End()).Offset
+
+		return scaffolding.Splice(src, start, end, want), nil
+	})
+}
+
+// benchmarkFormat is the benchmark added to tests by fixBenchmark, matching
+// the one in scaffolded tests. Its verb is replaced by one test case per part.
+const benchmarkFormat = `
+func Benchmark(b *testing.B) {
+	testCases := map[string]struct {
+		solution  helpers.Solution
+		inputFile string
+	}{
+%s	}
+
+	for name, test := range testCases {
+		b.Run(name, func(b *testing.B) {
+			helpers.BenchmarkSolution(b, test.solution, test.inputFile)
+		})
+	}
+}
+`
+
+// fixBenchmark adds a benchmark of every implemented part to a test file.
+func fixBenchmark(workdir, name string) error {
+	path := filepath.Join(workdir, name)
+
+	parts, err := scaffolding.SolutionParts(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+
+	modulePath, err := scaffolding.ModulePath(workdir)
+	if err != nil {
+		return err
+	}
+
+	return editGoFile(path, func(fset *token.FileSet, file *ast.File, src []byte) ([]byte, error) {
+		var cases strings.Builder
+		for _, part := range parts {
+			funcName := scaffolding.PartFuncName(part)
+			fmt.Fprintf(&cases, "%q: {\nsolution: helpers.SolutionFunc(%s),\ninputFile: \"testdata/input.txt\",\n},\n", funcName, funcName)
+		}
+
+		src = append(bytes.TrimRight(src, "\n"), '\n')
+		src = fmt.Appendf(src, benchmarkFormat, cases.String())
+
+		return addImports(src, "testing", modulePath+"/helpers")
+	})
+}
+
+// addImports adds the given imports to the Go source src, unless it already
+// has them. Imports are rewritten as a single declaration, with the standard
+// library grouped apart from other packages.
+func addImports(src []byte, paths ...string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	var std, others []string
+	existing := make(map[string]bool)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		existing[path] = true
+
+		line := spec.Path.Value
+		if spec.Name != nil {
+			line = spec.Name.Name + " " + line
+		}
+		if isStandardPackage(path) {
+			std = append(std, line)
+		} else {
+			others = append(others, line)
+		}
+	}
+
+	added := false
+	for _, path := range paths {
+		if existing[path] {
+			continue
+		}
+		added = true
+		if isStandardPackage(path) {
+			std = append(std, strconv.Quote(path))
+		} else {
+			others = append(others, strconv.Quote(path))
+		}
+	}
+	if !added {
+		return src, nil
+	}
+
+	var decl strings.Builder
+	decl.WriteString("import (\n")
+	for _, line := range std {
+		decl.WriteString(line + "\n")
+	}
+	if len(std) > 0 && len(others) > 0 {
+		decl.WriteString("\n")
+	}
+	for _, line := range others {
+		decl.WriteString(line + "\n")
+	}
+	decl.WriteString(")")
+
+	// Replace existing import declarations, last to first so that offsets
+	// remain valid.
+	var imports []*ast.GenDecl
+	for _, d := range file.Decls {
+		if gen, ok := d.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			imports = append(imports, gen)
+		}
+	}
+	if len(imports) == 0 {
+		offset := fset.Position(file.Name.End()).Offset
+		return scaffolding.Splice(src, offset, offset, "\n\n"+decl.String()), nil
+	}
+	for i := len(imports) - 1; i > 0; i-- {
+		src = scaffolding.Splice(src, fset.Position(imports[i].Pos()).Offset, fset.Position(imports[i].End()).Offset, "")
+	}
+	return scaffolding.Splice(src, fset.Position(imports[0].Pos()).Offset, fset.Position(imports[0].End()).Offset, decl.String()), nil
+}
+
+// isStandardPackage reports whether path is the import path of a package of
+// the standard library, which has no dot in its first element.
+func isStandardPackage(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// fixLineEndings replaces CRLF line endings with LF in a file.
+func fixLineEndings(workdir, name string) error {
+	path := filepath.Join(workdir, name)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// editGoFile parses the Go file at path, edits its source with edit, and
+// writes it back formatted.
+func editGoFile(path string, edit func(*token.FileSet, *ast.File, []byte) ([]byte, error)) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", path, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %w", path, err)
+	}
+
+	edited, err := edit(fset, file, src)
+	if err != nil {
+		return err
+	}
+
+	code, err := format.Source(edited)
+	if err != nil {
+		return fmt.Errorf("formatting %q: %w", path, err)
+	}
+
+	if err := os.WriteFile(path, code, 0644); err != nil {
+		return fmt.Errorf("writing %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// WriteIssues writes issues to w, in the given format.
+func WriteIssues(w io.Writer, issues []Issue, format string) error {
+	switch format {
+	case FormatText:
+		var b strings.Builder
+		for _, i := range issues {
+			b.WriteString(i.String())
+			if i.Fixable {
+				b.WriteString(" [fixable]")
+			}
+			b.WriteString("\n")
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	case FormatJSON:
+		if issues == nil {
+			issues = []Issue{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issues)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
@@ -0,0 +1,167 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiagnose(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "go.mod"), "module example.com/aoc\n")
+
+	// Day 1 has no benchmark, an unknown answer, and bad inputs.
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"), testExamples)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt"), "1\n2\r\n3\r\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "alice", "input.txt"), "")
+
+	// Day 2 has the wrong package name, and no tests at all.
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d02", "solution.go"), strings.Replace(testSolution, "d01", "main", 1))
+
+	// Day 3 does not parse.
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d03", "solution.go"), "package d03\n\nfunc PartOne(\n")
+
+	// Other directories are ignored.
+	writeTestFile(t, filepath.Join(workdir, "y2024", "notes", "solution.go"), "package notes\n")
+
+	issues, err := Diagnose(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Issue{
+		{CheckBenchmark, "y2024/d01/solution_test.go", 1, "package has no benchmark", true},
+		{CheckExampleAnswer, "y2024/d01/solution_test.go", 8, "ExamplePartTwo has no answer", false},
+		{CheckInput, "y2024/d01/testdata/alice/input.txt", 1, "input is empty", false},
+		{CheckInput, "y2024/d01/testdata/input.txt", 2, "input has CRLF line endings", true},
+		{CheckPackageName, "y2024/d02/solution.go", 1, "package main should be named d02", true},
+		{CheckBenchmark, "y2024/d02/solution.go", 1, "package has no benchmark", false},
+		{CheckExampleAnswer, "y2024/d02/solution_test.go", 1, "ExamplePartOne is missing", false},
+		{CheckExampleAnswer, "y2024/d02/solution_test.go", 1, "ExamplePartTwo is missing", false},
+		{CheckSyntax, "y2024/d03/solution.go", 3, "expected ')', found 'EOF'", false},
+	}
+	for i := range want {
+		want[i].File = filepath.FromSlash(want[i].File)
+	}
+	if diff := cmp.Diff(want, issues); diff != "" {
+		t.Errorf("issues mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFix(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "go.mod"), "module example.com/aoc\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"), strings.Replace(testExamples, "d01\n", "d01\n\nimport \"fmt\"\n\nvar _ = fmt.Sprint\n", 1))
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt"), "1\r\n2\r\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d02", "solution.go"), strings.Replace(testSolution, "d01", "busser", 1))
+
+	issues, err := Diagnose(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fixed, err := Fix(workdir, issues)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fixed) != 3 {
+		t.Errorf("fixed %d issues, want 3:\n%v", len(fixed), fixed)
+	}
+
+	remaining, err := Diagnose(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, issue := range remaining {
+		if issue.Fixable {
+			t.Errorf("issue not fixed: %s", issue)
+		}
+	}
+
+	wantTest := `package d01
+
+import (
+	"fmt"
+	"testing"
+
+	"example.com/aoc/helpers"
+)
+
+var _ = fmt.Sprint
+
+func ExamplePartOne() {
+	// Output: 42
+}
+
+func ExamplePartTwo() {
+	// Output: 👉 Write the answer here 👈
+}
+
+func Benchmark(b *testing.B) {
+	testCases := map[string]struct {
+		solution  helpers.Solution
+		inputFile string
+	}{
+		"PartOne": {
+			solution:  helpers.SolutionFunc(PartOne),
+			inputFile: "testdata/input.txt",
+		},
+		"PartTwo": {
+			solution:  helpers.SolutionFunc(PartTwo),
+			inputFile: "testdata/input.txt",
+		},
+	}
+
+	for name, test := range testCases {
+		b.Run(name, func(b *testing.B) {
+			helpers.BenchmarkSolution(b, test.solution, test.inputFile)
+		})
+	}
+}
+`
+	if diff := cmp.Diff(wantTest, readTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"))); diff != "" {
+		t.Errorf("test file mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := readTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt")); got != "1\n2\n" {
+		t.Errorf("unexpected input: %q", got)
+	}
+
+	if got := readTestFile(t, filepath.Join(workdir, "y2024", "d02", "solution.go")); !strings.HasPrefix(got, "package d02\n") {
+		t.Errorf("package not renamed:\n%s", got)
+	}
+}
+
+func TestWriteIssues(t *testing.T) {
+	issues := []Issue{
+		{CheckBenchmark, "y2024/d01/solution_test.go", 1, "package has no benchmark", true},
+		{CheckExampleAnswer, "y2024/d01/solution_test.go", 9, "ExamplePartTwo has no answer", false},
+	}
+
+	var b strings.Builder
+	if err := WriteIssues(&b, issues, FormatText); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `y2024/d01/solution_test.go:1: package has no benchmark (benchmark) [fixable]
+y2024/d01/solution_test.go:9: ExamplePartTwo has no answer (example-answer)
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("output mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
@@ -0,0 +1,95 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+// A Solution describes the registered solution of a puzzle.
+type Solution struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+
+	// Import path of the package, and its path relative to the working
+	// directory.
+	Package string `json:"package"`
+	Dir     string `json:"dir"`
+
+	// Parts of the puzzle the package solves.
+	Parts []int `json:"parts"`
+
+	// Whether the package has a non-empty testdata/input.txt file.
+	HasInput bool `json:"input"`
+}
+
+// ListSolutions groups registered solutions by puzzle, and describes their
+// packages in workdir. Keys must be sorted by year, day, and part.
+func ListSolutions(workdir string, keys []registry.Key) ([]Solution, error) {
+	modulePath, err := scaffolding.ModulePath(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var solutions []Solution
+
+	for _, k := range keys {
+		if n := len(solutions); n > 0 && solutions[n-1].Year == k.Year && solutions[n-1].Day == k.Day {
+			solutions[n-1].Parts = append(solutions[n-1].Parts, k.Part)
+			continue
+		}
+
+		s := Solution{
+			Year:    k.Year,
+			Day:     k.Day,
+			Package: path.Join(modulePath, k.PackageDir()),
+			Dir:     filepath.FromSlash(k.PackageDir()),
+			Parts:   []int{k.Part},
+		}
+		if info, err := os.Stat(filepath.Join(workdir, k.InputFile())); err == nil {
+			s.HasInput = info.Size() > 0
+		}
+
+		solutions = append(solutions, s)
+	}
+
+	return solutions, nil
+}
+
+// WriteSolutions writes solutions to w, in the given format.
+func WriteSolutions(w io.Writer, solutions []Solution, format string) error {
+	switch format {
+	case FormatText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprint(tw, "Puzzle\tPackage\tParts\tInput\n")
+		for _, s := range solutions {
+			parts := make([]string, len(s.Parts))
+			for i, p := range s.Parts {
+				parts[i] = fmt.Sprint(p)
+			}
+			input := "yes"
+			if !s.HasInput {
+				input = "no"
+			}
+			fmt.Fprintf(tw, "%d/%02d\t%s\t%s\t%s\n", s.Year, s.Day, s.Package, strings.Join(parts, ","), input)
+		}
+		return tw.Flush()
+	case FormatJSON:
+		if solutions == nil {
+			solutions = []Solution{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(solutions)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
@@ -0,0 +1,82 @@
+package workspace
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/busser/adventofcode/registry"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestListSolutions(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "go.mod"), "module example.com/aoc\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt"), "1\n2\n")
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d25", "testdata", "input.txt"), "")
+
+	keys := []registry.Key{
+		{Year: 2024, Day: 1, Part: 1},
+		{Year: 2024, Day: 1, Part: 2},
+		{Year: 2024, Day: 2, Part: 1},
+		{Year: 2024, Day: 25, Part: 1},
+	}
+
+	solutions, err := ListSolutions(workdir, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Solution{
+		{2024, 1, "example.com/aoc/y2024/d01", filepath.Join("y2024", "d01"), []int{1, 2}, true},
+		{2024, 2, "example.com/aoc/y2024/d02", filepath.Join("y2024", "d02"), []int{1}, false},
+		{2024, 25, "example.com/aoc/y2024/d25", filepath.Join("y2024", "d25"), []int{1}, false},
+	}
+	if diff := cmp.Diff(want, solutions); diff != "" {
+		t.Fatalf("solutions mismatch (-want +got):\n%s", diff)
+	}
+
+	var b strings.Builder
+	if err := WriteSolutions(&b, solutions[:2], FormatJSON); err != nil {
+		t.Fatal(err)
+	}
+	wantJSON := `[
+  {
+    "year": 2024,
+    "day": 1,
+    "package": "example.com/aoc/y2024/d01",
+    "dir": "y2024/d01",
+    "parts": [
+      1,
+      2
+    ],
+    "input": true
+  },
+  {
+    "year": 2024,
+    "day": 2,
+    "package": "example.com/aoc/y2024/d02",
+    "dir": "y2024/d02",
+    "parts": [
+      1
+    ],
+    "input": false
+  }
+]
+`
+	if diff := cmp.Diff(wantJSON, b.String()); diff != "" {
+		t.Errorf("JSON mismatch (-want +got):\n%s", diff)
+	}
+
+	b.Reset()
+	if err := WriteSolutions(&b, solutions[:2], FormatText); err != nil {
+		t.Fatal(err)
+	}
+	wantText := `Puzzle   Package                    Parts  Input
+2024/01  example.com/aoc/y2024/d01  1,2    yes
+2024/02  example.com/aoc/y2024/d02  1      no
+`
+	if diff := cmp.Diff(wantText, b.String()); diff != "" {
+		t.Errorf("text mismatch (-want +got):\n%s", diff)
+	}
+}
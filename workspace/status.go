@@ -0,0 +1,117 @@
+package workspace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Formats supported by WriteStatus.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Symbols used in the calendar rendered by WriteStatus.
+const (
+	symbolSolved     = "★"
+	symbolUnanswered = "☆"
+	symbolMissing    = "·"
+	symbolNoInput    = "!"
+)
+
+// WriteStatus writes a summary of the state of years to w, in the given format.
+func WriteStatus(w io.Writer, years []Year, format string) error {
+	switch format {
+	case FormatText:
+		return writeStatusText(w, years)
+	case FormatJSON:
+		return writeStatusJSON(w, years)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// writeStatusText renders years as an Advent calendar, with one row per year
+// and one column per day.
+func writeStatusText(w io.Writer, years []Year) error {
+	maxDays := 0
+	for _, y := range years {
+		maxDays = max(maxDays, len(y.Days))
+	}
+
+	var b strings.Builder
+
+	b.WriteString("    ")
+	for d := 1; d <= maxDays; d++ {
+		fmt.Fprintf(&b, " %3d", d)
+	}
+	b.WriteString("\n")
+
+	total := 0
+	for _, y := range years {
+		fmt.Fprintf(&b, "%d", y.Year)
+		for _, d := range y.Days {
+			b.WriteString(" " + dayCell(d))
+		}
+		for d := len(y.Days); d < maxDays; d++ {
+			b.WriteString("    ")
+		}
+		fmt.Fprintf(&b, "  %2d/%d ★\n", y.Stars(), 2*len(y.Days))
+		total += y.Stars()
+	}
+
+	fmt.Fprintf(&b, "\nTotal: %d ★\n", total)
+	fmt.Fprintf(&b, "Legend: %s solved  %s answer unknown  %s not started  %s missing input\n",
+		symbolSolved, symbolUnanswered, symbolMissing, symbolNoInput)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dayCell renders the state of d in three characters: one for each part, and
+// one for the input.
+func dayCell(d Day) string {
+	var b strings.Builder
+
+	for _, p := range d.Parts {
+		switch {
+		case p.Solved:
+			b.WriteString(symbolSolved)
+		case p.Implemented:
+			b.WriteString(symbolUnanswered)
+		default:
+			b.WriteString(symbolMissing)
+		}
+	}
+
+	if d.HasSolution && !d.HasInput {
+		b.WriteString(symbolNoInput)
+	} else {
+		b.WriteString(" ")
+	}
+
+	return b.String()
+}
+
+func writeStatusJSON(w io.Writer, years []Year) error {
+	type yearStatus struct {
+		Year
+		Stars int `json:"stars"`
+	}
+
+	status := struct {
+		Years []yearStatus `json:"years"`
+		Stars int          `json:"stars"`
+	}{}
+
+	for _, y := range years {
+		status.Years = append(status.Years, yearStatus{Year: y, Stars: y.Stars()})
+		status.Stars += y.Stars()
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(status)
+}
@@ -0,0 +1,171 @@
+// Package workspace inspects the solutions stored in an Advent of Code working
+// directory.
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/busser/adventofcode/aoc"
+	"github.com/busser/adventofcode/scaffolding"
+)
+
+// A Part describes the state of one part of a puzzle's solution.
+type Part struct {
+	Number int `json:"part"`
+
+	// Whether the package exports a function solving the part.
+	Implemented bool `json:"implemented"`
+
+	// Expected output of the part's example, if known.
+	Answer string `json:"answer,omitempty"`
+
+	// Whether the part is implemented and its answer known.
+	Solved bool `json:"solved"`
+}
+
+// A Day describes the state of a puzzle's solution.
+type Day struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+
+	// Path of the package, relative to the working directory.
+	Dir string `json:"dir"`
+
+	// Whether the package has a solution.go file.
+	HasSolution bool `json:"solution"`
+
+	// Whether the package has a non-empty testdata/input.txt file.
+	HasInput bool `json:"input"`
+
+	Parts []Part `json:"parts"`
+}
+
+// Stars returns the number of solved parts of d.
+func (d Day) Stars() int {
+	stars := 0
+	for _, p := range d.Parts {
+		if p.Solved {
+			stars++
+		}
+	}
+	return stars
+}
+
+// A Year describes the state of all solutions of an Advent of Code calendar.
+type Year struct {
+	Year int   `json:"year"`
+	Days []Day `json:"days"`
+}
+
+// Stars returns the number of solved parts of y.
+func (y Year) Stars() int {
+	stars := 0
+	for _, d := range y.Days {
+		stars += d.Stars()
+	}
+	return stars
+}
+
+// Scan inspects every year of workdir with at least one day directory. Each
+// year lists all days of its calendar, whether they have a package or not.
+func Scan(workdir string) ([]Year, error) {
+	dirs, err := scaffolding.SolutionDirs(workdir)
+	if err != nil {
+		return nil, err
+	}
+
+	var years []Year
+
+	for _, dir := range dirs {
+		if len(years) > 0 && years[len(years)-1].Year == dir.Year {
+			continue
+		}
+
+		year, err := ScanYear(workdir, dir.Year)
+		if err != nil {
+			return nil, err
+		}
+		years = append(years, year)
+	}
+
+	return years, nil
+}
+
+// ScanYear inspects all days of the given year in workdir.
+func ScanYear(workdir string, number int) (Year, error) {
+	year := Year{Year: number}
+
+	for d := 1; d <= aoc.DaysInYear(number); d++ {
+		day, err := ScanDay(workdir, number, d)
+		if err != nil {
+			return Year{}, err
+		}
+		year.Days = append(year.Days, day)
+	}
+
+	// The second part of the last puzzle is a freebie, given once all other
+	// parts are solved.
+	last := &year.Days[len(year.Days)-1]
+	if len(last.Parts) == 2 && !last.Parts[1].Implemented {
+		last.Parts[1].Solved = year.Stars() == 2*len(year.Days)-1
+	}
+
+	return year, nil
+}
+
+// ScanDay inspects the package of the given day in workdir.
+func ScanDay(workdir string, year, day int) (Day, error) {
+	d := Day{
+		Year: year,
+		Day:  day,
+		Dir:  filepath.Join(fmt.Sprintf("y%04d", year), fmt.Sprintf("d%02d", day)),
+		Parts: []Part{
+			{Number: 1},
+			{Number: 2},
+		},
+	}
+	dir := filepath.Join(workdir, d.Dir)
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return d, nil
+	}
+
+	d.HasSolution = fileExists(filepath.Join(dir, "solution.go"))
+
+	if info, err := os.Stat(filepath.Join(dir, "testdata", "input.txt")); err == nil {
+		d.HasInput = info.Size() > 0
+	}
+
+	implemented, err := scaffolding.SolutionParts(dir)
+	if err != nil {
+		return Day{}, err
+	}
+	for _, number := range implemented {
+		d.Parts[number-1].Implemented = true
+	}
+
+	testFile := filepath.Join(dir, "solution_test.go")
+	for i := range d.Parts {
+		p := &d.Parts[i]
+		if !p.Implemented || !fileExists(testFile) {
+			continue
+		}
+
+		answer, err := scaffolding.ReadExampleAnswer(testFile, p.Number)
+		if err != nil || answer == scaffolding.AnswerPlaceholder {
+			continue
+		}
+
+		p.Answer = answer
+		p.Solved = answer != ""
+	}
+
+	return d, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
@@ -0,0 +1,110 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testSolution = `package d01
+
+import "io"
+
+func PartOne(r io.Reader, w io.Writer) error { return nil }
+func PartTwo(r io.Reader, w io.Writer) error { return nil }
+`
+	testExamples = `package d01
+
+func ExamplePartOne() {
+	// Output: 42
+}
+
+func ExamplePartTwo() {
+	// Output: 👉 Write the answer here 👈
+}
+`
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"), testExamples)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "testdata", "input.txt"), "1\n2\n")
+	writeTestFile(t, filepath.Join(workdir, "y2025", "d03", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "not-a-year", "d01", "solution.go"), testSolution)
+
+	years, err := Scan(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(years) != 2 || years[0].Year != 2024 || years[1].Year != 2025 {
+		t.Fatalf("got unexpected years: %+v", years)
+	}
+	if len(years[0].Days) != 25 || len(years[1].Days) != 12 {
+		t.Fatalf("got %d and %d days, want 25 and 12", len(years[0].Days), len(years[1].Days))
+	}
+
+	d01 := years[0].Days[0]
+	if !d01.HasSolution || !d01.HasInput {
+		t.Errorf("expected day 1 to have a solution and an input: %+v", d01)
+	}
+	if !d01.Parts[0].Solved || d01.Parts[0].Answer != "42" {
+		t.Errorf("expected part one to be solved with answer 42: %+v", d01.Parts[0])
+	}
+	if !d01.Parts[1].Implemented || d01.Parts[1].Solved {
+		t.Errorf("expected part two to be implemented but not solved: %+v", d01.Parts[1])
+	}
+	if years[0].Stars() != 1 {
+		t.Errorf("got %d stars in 2024, want 1", years[0].Stars())
+	}
+
+	d03 := years[1].Days[2]
+	if !d03.HasSolution || d03.HasInput || d03.Stars() != 0 {
+		t.Errorf("expected day 3 of 2025 to have a solution only: %+v", d03)
+	}
+}
+
+func TestWriteStatus(t *testing.T) {
+	workdir := t.TempDir()
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution.go"), testSolution)
+	writeTestFile(t, filepath.Join(workdir, "y2024", "d01", "solution_test.go"), testExamples)
+
+	years, err := Scan(workdir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var text strings.Builder
+	if err := WriteStatus(&text, years, FormatText); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(text.String(), "2024 ★☆! ··  ") || !strings.Contains(text.String(), "1/50 ★") {
+		t.Errorf("unexpected text status:\n%s", text.String())
+	}
+
+	var json strings.Builder
+	if err := WriteStatus(&json, years, FormatJSON); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(json.String(), `"stars": 1`) {
+		t.Errorf("unexpected JSON status:\n%s", json.String())
+	}
+
+	if err := WriteStatus(&json, years, "yaml"); err == nil {
+		t.Errorf("expected error for unknown format")
+	}
+}